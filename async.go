@@ -0,0 +1,63 @@
+package valigo
+
+import (
+	"sync"
+
+	"github.com/insei/valigo/shared"
+)
+
+// pendingResult is a result of the async rule, it is used as the placeholder error until the rule completes.
+type pendingResult struct {
+	errs []shared.Error
+}
+
+// Error implements the error interface.
+func (p *pendingResult) Error() string {
+	return "pending async rule result"
+}
+
+// asyncRunner implements shared.AsyncRunner, it runs async rules in goroutines
+// with the concurrency limit.
+type asyncRunner struct {
+	sem chan struct{}
+	wg  sync.WaitGroup
+}
+
+// newAsyncRunner creates a new asyncRunner with the concurrency limit.
+func newAsyncRunner(limit int) *asyncRunner {
+	return &asyncRunner{sem: make(chan struct{}, limit)}
+}
+
+// Go runs fn in a goroutine, it blocks while the concurrency limit is reached.
+func (r *asyncRunner) Go(fn func() []shared.Error) shared.Error {
+	p := &pendingResult{}
+	r.sem <- struct{}{}
+	r.wg.Add(1)
+	go func() {
+		defer func() {
+			<-r.sem
+			r.wg.Done()
+		}()
+		p.errs = fn()
+	}()
+	return shared.Error{Err: p}
+}
+
+// resolve waits for all async rules and replaces the placeholders in errs with the rules results,
+// so the errors are in the rules declaration order.
+func (r *asyncRunner) resolve(errs []shared.Error) []shared.Error {
+	r.wg.Wait()
+	resolved := make([]shared.Error, 0, len(errs))
+	for _, err := range errs {
+		p, ok := err.Err.(*pendingResult)
+		if !ok {
+			resolved = append(resolved, err)
+			continue
+		}
+		for _, pErr := range p.errs {
+			pErr.Location = joinLocation(err.Location, pErr.Location)
+			resolved = append(resolved, pErr)
+		}
+	}
+	return resolved
+}
@@ -0,0 +1,110 @@
+package valigo
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insei/valigo/shared"
+)
+
+type signup struct {
+	Email    string
+	Login    string
+	Name     string
+	Address  address
+	Password string
+}
+
+func TestValidatorAsyncCustom(t *testing.T) {
+	var running, maxRunning atomic.Int32
+	lookup := func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		return []shared.Error{h.ErrorT(ctx, value, "already taken")}
+	}
+	newValidator := func(opts ...Option) *Validator {
+		v := New(opts...)
+		Configure[address](v, func(c Configurator[address], obj *address) {
+			c.String(&obj.City).Custom(lookup, shared.WithAsync())
+		})
+		Configure[signup](v, func(c Configurator[signup], obj *signup) {
+			c.String(&obj.Email).Custom(lookup, shared.WithAsync())
+			c.String(&obj.Login).Custom(lookup, shared.WithAsync())
+			c.String(&obj.Name).Required()
+			c.Struct(&obj.Address)
+			c.Custom(func(ctx context.Context, h shared.StructCustomHelper, obj *signup) []shared.Error {
+				time.Sleep(20 * time.Millisecond)
+				return []shared.Error{h.ErrorT(ctx, &obj.Password, obj.Password, "weak")}
+			}, shared.WithAsync())
+		})
+		return v
+	}
+	locations := []string{"Email", "Login", "Name", "Address.City", "Password"}
+
+	testCases := []struct {
+		name       string
+		opts       []Option
+		maxRunning int32
+		locations  []string
+	}{
+		{
+			name:       "sequential",
+			maxRunning: 1,
+			locations:  locations,
+		},
+		{
+			name:       "concurrent",
+			opts:       []Option{WithAsyncConcurrency(10)},
+			maxRunning: 3,
+			locations:  locations,
+		},
+		{
+			name:       "concurrency limit",
+			opts:       []Option{WithAsyncConcurrency(2)},
+			maxRunning: 2,
+			locations:  locations,
+		},
+		{
+			name:       "max errors",
+			opts:       []Option{WithAsyncConcurrency(10), WithMaxErrors(2)},
+			maxRunning: 3,
+			locations:  locations[:2],
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			maxRunning.Store(0)
+			v := newValidator(tc.opts...)
+			errs := v.ValidateTyped(context.Background(), &signup{})
+			var locations []string
+			for _, err := range errs {
+				locations = append(locations, err.Location)
+			}
+			assert.Equal(t, tc.locations, locations)
+			assert.Equal(t, tc.maxRunning, maxRunning.Load())
+		})
+	}
+}
+
+func TestValidatorAsyncCustomBail(t *testing.T) {
+	v := New(WithAsyncConcurrency(2))
+	Configure[signup](v, func(c Configurator[signup], obj *signup) {
+		c.String(&obj.Email).Bail().Custom(func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error {
+			return []shared.Error{h.ErrorT(ctx, value, "already taken")}
+		}, shared.WithAsync()).Required()
+	})
+	errs := v.ValidateTyped(context.Background(), &signup{})
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "already taken", errs[0].Message)
+}
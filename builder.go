@@ -2,8 +2,10 @@ package valigo
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/insei/fmap/v3"
+
 	"github.com/insei/valigo/num"
 	"github.com/insei/valigo/shared"
 	"github.com/insei/valigo/str"
@@ -16,9 +18,11 @@ type builder[T any] struct {
 	*str.StringBundle
 	*num.NumberBundle
 	*uuid.UUIDBundle
-	obj       any
-	v         *Validator
-	enablerFn func(ctx context.Context, obj any) bool
+	obj   any
+	v     *Validator
+	batch *batch
+	cond  *condition
+	errFn shared.ConfigErrorFn
 }
 
 // When adds a condition to the builder.
@@ -26,18 +30,23 @@ type builder[T any] struct {
 // The function is called with the context and the object being validated.
 // If the function returns true, the validation is enabled.
 func (b *builder[T]) When(fn func(ctx context.Context, obj *T) bool) Configurator[T] {
-	return configure[T](b.v, b.obj, func(ctx context.Context, obj any) bool {
-		enablerFn := fn
-		if b.enablerFn != nil {
-			enablerFn = func(ctx context.Context, obj *T) bool {
-				if b.enablerFn(ctx, obj) && fn(ctx, obj) {
-					return true
-				}
-				return false
+	return b.withEnabler(func(ctx context.Context, obj any) bool {
+		return fn(ctx, obj.(*T))
+	})
+}
+
+// withEnabler returns a builder with the enabler composed with the parent builder enabler.
+func (b *builder[T]) withEnabler(fn func(ctx context.Context, obj any) bool) *builder[T] {
+	enablerFn := fn
+	if parentFn := b.cond.getEnabler(); parentFn != nil {
+		enablerFn = func(ctx context.Context, obj any) bool {
+			if parentFn(ctx, obj) && fn(ctx, obj) {
+				return true
 			}
+			return false
 		}
-		return enablerFn(ctx, obj.(*T))
-	})
+	}
+	return newBuilder[T](b.v, b.obj, b.batch, b.cond.withEnabler(enablerFn), b.errFn)
 }
 
 // errorTFn represents a function that returns an error.
@@ -52,100 +61,127 @@ func (e errorTFn) ErrorT(ctx context.Context, ptrToFieldValue, fieldValue any, l
 	return e(ctx, ptrToFieldValue, fieldValue, localeKey, args...)
 }
 
+// newStructCustomHelper returns the shared.StructCustomHelper implementation for the obj,
+// the fields of the errors are found by the pointers to the obj fields.
+func newStructCustomHelper(fields fmap.Storage, obj any, h shared.Helper) errorTFn {
+	return func(ctx context.Context, ptrToField, fieldValue any, localeKey string, args ...any) shared.Error {
+		field, err := fields.GetFieldByPtr(obj, ptrToField)
+		if err != nil {
+			panic(err)
+		}
+		return h.ErrorT(ctx, field, fieldValue, localeKey, args...)
+	}
+}
+
 // Custom adds a custom validation function to the builder.
 // It takes a function that takes a context, a helper, and an object as input,
 // and returns a slice of shared.Error.
 // The helper is used to create errors.
-func (b *builder[T]) Custom(fn func(ctx context.Context, h shared.StructCustomHelper, obj *T) []shared.Error) {
-	newHFn := func(obj any, h shared.Helper) errorTFn {
-		return func(ctx context.Context, ptrToField, fieldValue any, localeKey string, args ...any) shared.Error {
-			fields, err := fmap.GetFrom(obj)
-			if err != nil {
-				panic(err)
-			}
-			field, err := fields.GetFieldByPtr(obj, ptrToField)
-			if err != nil {
-				panic(err)
-			}
-			return h.ErrorT(ctx, field, fieldValue, localeKey, args...)
-		}
+// The fields the rule depends on can be declared with the shared.WithDependsOn option for the partial validation,
+// the timeout of the rule can be set with the shared.WithTimeout option.
+func (b *builder[T]) Custom(fn func(ctx context.Context, h shared.StructCustomHelper, obj *T) []shared.Error, opts ...shared.CustomOption) {
+	fields, err := getFields(b.obj)
+	if err != nil {
+		b.errFn.Report(shared.NewConfigError(nil, "%v", err))
+		return
+	}
+	options := shared.NewCustomOptions(opts...)
+	dependsOn, err := getFieldPaths(b.obj, options.DependsOn)
+	if err != nil {
+		b.errFn.Report(shared.NewConfigError(nil, "custom rule depends on: %v", err))
+		return
 	}
 	fnConvert := func(ctx context.Context, h shared.Helper, objAny any) []shared.Error {
-		return fn(ctx, newHFn(objAny, h), objAny.(*T))
+		return fn(ctx, newStructCustomHelper(fields, objAny, h), objAny.(*T))
 	}
-	b.v.storage.newOnStructAppend(b.obj, b.enablerFn, fnConvert)
+	b.batch.describe(descriptor{cond: b.cond, rule: shared.Rule{Name: shared.RuleCustom}})
+	b.batch.newOnStructAppend(b.cond, options.Wrap(fnConvert), dependsOn...)
 }
 
 func (b *builder[T]) StringSlice(sliceFieldPtr any) *str.StringSliceFieldConfigurator {
-	fields, err := fmap.GetFrom(b.obj)
-	if err != nil {
-		panic(err)
-	}
-	field, err := fields.GetFieldByPtr(b.obj, sliceFieldPtr)
-	if err != nil {
-		panic(err)
-	}
-	appendFn := b.v.storage.newOnFieldAppend(b.obj, b.enablerFn)
-	return str.NewStringSliceFieldConfigurator(shared.SliceFieldConfiguratorParams{
-		Field:  field,
-		Helper: b.v.GetHelper(),
-		AppendFn: func(fn shared.FieldValidationFn) {
-			appendFn(field, fn)
-		},
-	})
+	return str.NewStringSliceFieldConfigurator(b.fieldRules(sliceFieldPtr, "slice field").sliceParams(b.v.GetHelper()))
 }
 
 func (b *builder[T]) UUIDSlice(sliceFieldPtr any) *uuid.UUIDSliceFieldConfigurator {
-	fields, err := fmap.GetFrom(b.obj)
-	if err != nil {
-		panic(err)
-	}
-	field, err := fields.GetFieldByPtr(b.obj, sliceFieldPtr)
-	if err != nil {
-		panic(err)
-	}
-	appendFn := b.v.storage.newOnFieldAppend(b.obj, b.enablerFn)
-	return uuid.NewUUIDSliceFieldConfigurator(shared.SliceFieldConfiguratorParams{
-		Field:  field,
-		Helper: b.v.GetHelper(),
-		AppendFn: func(fn shared.FieldValidationFn) {
-			appendFn(field, fn)
-		},
-	})
+	return uuid.NewUUIDSliceFieldConfigurator(b.fieldRules(sliceFieldPtr, "slice field").sliceParams(b.v.GetHelper()))
 }
 
 func (b *builder[T]) Slice(sliceFieldPtr any) *shared.SliceFieldConfigurator {
-	fields, err := fmap.GetFrom(b.obj)
-	if err != nil {
-		panic(err)
+	return shared.NewSliceFieldConfigurator(b.fieldRules(sliceFieldPtr, "slice field").sliceParams(b.v.GetHelper()))
+}
+
+// fieldRules is the registration of the field rules: validation functions, descriptors and configuration errors.
+type fieldRules struct {
+	// field is the configured field, nil for the unknown field.
+	field      fmap.Field
+	appendFn   func(fn shared.FieldValidationFn)
+	errFn      shared.ConfigErrorFn
+	describeFn shared.DescribeFn
+}
+
+// discard returns the field rules registration discarding the rules, their descriptors and configuration errors,
+// it is used for the invalid field configuration.
+func (r fieldRules) discard() fieldRules {
+	return fieldRules{
+		field:    r.field,
+		appendFn: shared.DiscardFieldValidationFn,
+		errFn:    shared.DiscardConfigError,
+	}
+}
+
+// sliceParams returns the parameters of the slice field configurator.
+func (r fieldRules) sliceParams(h shared.Helper) shared.SliceFieldConfiguratorParams {
+	return shared.SliceFieldConfiguratorParams{
+		Field:      r.field,
+		Helper:     h,
+		AppendFn:   r.appendFn,
+		ErrorFn:    r.errFn,
+		DescribeFn: r.describeFn,
 	}
-	field, err := fields.GetFieldByPtr(b.obj, sliceFieldPtr)
+}
+
+// fieldRules returns the rules registration of the field of the object by the pointer.
+// The configuration error is reported for the unknown field, in this case the field is nil
+// and the following rules configuration is discarded.
+func (b *builder[T]) fieldRules(fieldPtr any, kind string) fieldRules {
+	field, err := getFieldByPtr(b.obj, fieldPtr)
 	if err != nil {
-		panic(err)
+		b.errFn.Report(shared.NewConfigError(nil, "%s: %v", kind, err))
+		return fieldRules{}.discard()
 	}
-	appendFn := b.v.storage.newOnFieldAppend(b.obj, b.enablerFn)
-	return shared.NewSliceFieldConfigurator(shared.SliceFieldConfiguratorParams{
-		Field:  field,
-		Helper: b.v.GetHelper(),
-		AppendFn: func(fn shared.FieldValidationFn) {
+	appendFn := b.batch.newOnFieldAppend(b.cond)
+	return fieldRules{
+		field: field,
+		appendFn: func(fn shared.FieldValidationFn) {
 			appendFn(field, fn)
 		},
-	})
+		errFn:      b.errFn,
+		describeFn: shared.NewFieldDescribeFn(field, b.batch.newOnFieldDescribe(b.cond)),
+	}
+}
+
+// configure creates a new builder with the given validator, object, and condition.
+// It takes a validator, an object, a condition and a configuration errors reporting function as input,
+// and returns a builder. Nil errFn means the configuration errors panic.
+// The configured rules are collected to the builder batch, they are published by the batch commit.
+func configure[T any](v *Validator, obj any, cond *condition, errFn shared.ConfigErrorFn) *builder[T] {
+	return newBuilder[T](v, obj, v.storage.newBatch(reflect.TypeOf(obj)), cond, errFn)
 }
 
-// configure creates a new builder with the given validator, object, and enabler function.
-// It takes a validator, an object, and an enabler function as input,
-// and returns a builder.
-func configure[T any](v *Validator, obj any, enabler func(ctx context.Context, obj any) bool) *builder[T] {
-	fields, err := fmap.GetFrom(obj)
+// newBuilder creates a new builder collecting the rules of the object to the batch b.
+func newBuilder[T any](v *Validator, obj any, b *batch, cond *condition, errFn shared.ConfigErrorFn) *builder[T] {
+	fields, err := getFields(obj)
 	if err != nil {
-		panic(err)
+		// the callers check the fields of the object before, the builder is unusable without them
+		errFn.Report(shared.NewConfigError(nil, "%v", err))
 	}
 	bundleDeps := shared.BundleDependencies{
-		Object:   obj,
-		Helper:   v.GetHelper(),
-		AppendFn: v.storage.newOnFieldAppend(obj, enabler),
-		Fields:   fields,
+		Object:     obj,
+		Helper:     v.GetHelper(),
+		AppendFn:   b.newOnFieldAppend(cond),
+		Fields:     fields,
+		ErrorFn:    errFn,
+		DescribeFn: b.newOnFieldDescribe(cond),
 	}
 	sb := str.NewStringBundle(bundleDeps)
 	nb := num.NewNumBundle(bundleDeps)
@@ -156,6 +192,8 @@ func configure[T any](v *Validator, obj any, enabler func(ctx context.Context, o
 		UUIDBundle:   ub,
 		obj:          obj,
 		v:            v,
-		enablerFn:    enabler,
+		batch:        b,
+		cond:         cond,
+		errFn:        errFn,
 	}
 }
@@ -17,14 +17,15 @@ func TestBuilderWhen(t *testing.T) {
 		Field: "test",
 	}
 	validator = New()
-	bld := configure[TestStruct](validator, obj, func(ctx context.Context, obj any) bool {
+	bld := configure[TestStruct](validator, obj, newCondition(func(ctx context.Context, obj any) bool {
 		return true
-	})
+	}), nil)
 	bld.When(func(ctx context.Context, obj *TestStruct) bool {
 		return (*obj).Field == "test"
 	}).String(&obj.Field).Required()
 
-	assert.Equal(t, 1, len(validator.storage.validators))
+	bld.batch.commit()
+	assert.Equal(t, 1, len(validator.storage.load().plans))
 }
 
 func TestBuilderCustom(t *testing.T) {
@@ -35,9 +36,9 @@ func TestBuilderCustom(t *testing.T) {
 		Field: "test",
 	}
 	vld := New()
-	bld := configure[*TestStruct](vld, obj, func(ctx context.Context, obj any) bool {
+	bld := configure[*TestStruct](vld, obj, newCondition(func(ctx context.Context, obj any) bool {
 		return true
-	})
+	}), nil)
 	bld.Custom(func(ctx context.Context, h shared.StructCustomHelper, obj **TestStruct) []shared.Error {
 		if (*obj).Field != "test" {
 			return []shared.Error{h.ErrorT(ctx, &(*obj).Field, (*obj).Field, "validation:string:Field is not 'test'")}
@@ -45,7 +46,8 @@ func TestBuilderCustom(t *testing.T) {
 		return nil
 	})
 
-	assert.Equal(t, 1, len(vld.storage.validators))
+	bld.batch.commit()
+	assert.Equal(t, 1, len(vld.storage.load().plans))
 }
 
 func TestBuilderWhenAndCustomMultipleConditions(t *testing.T) {
@@ -58,9 +60,9 @@ func TestBuilderWhenAndCustomMultipleConditions(t *testing.T) {
 		Field2: "test2",
 	}
 	vld := New()
-	bld := configure[TestStruct](vld, obj, func(ctx context.Context, obj any) bool {
+	bld := configure[TestStruct](vld, obj, newCondition(func(ctx context.Context, obj any) bool {
 		return true
-	})
+	}), nil)
 	bld.When(func(ctx context.Context, obj *TestStruct) bool {
 		return (*obj).Field1 == "test1"
 	}).When(func(ctx context.Context, obj *TestStruct) bool {
@@ -75,7 +77,8 @@ func TestBuilderWhenAndCustomMultipleConditions(t *testing.T) {
 		return nil
 	})
 
-	assert.Equal(t, 1, len(vld.storage.validators))
+	bld.batch.commit()
+	assert.Equal(t, 1, len(vld.storage.load().plans))
 }
 func TestBuilderCustomMultipleErrors(t *testing.T) {
 	type TestStruct struct {
@@ -87,9 +90,9 @@ func TestBuilderCustomMultipleErrors(t *testing.T) {
 		Field2: "test2",
 	}
 	vld := New()
-	bld := configure[*TestStruct](vld, obj, func(ctx context.Context, obj any) bool {
+	bld := configure[*TestStruct](vld, obj, newCondition(func(ctx context.Context, obj any) bool {
 		return true
-	})
+	}), nil)
 	bld.Custom(func(ctx context.Context, h shared.StructCustomHelper, obj **TestStruct) []shared.Error {
 		errs := make([]shared.Error, 0)
 		if (*obj).Field1 != "test1" {
@@ -101,7 +104,8 @@ func TestBuilderCustomMultipleErrors(t *testing.T) {
 		return errs
 	})
 
-	assert.Equal(t, 1, len(vld.storage.validators))
+	bld.batch.commit()
+	assert.Equal(t, 1, len(vld.storage.load().plans))
 }
 
 func TestConfigurePanic(t *testing.T) {
@@ -118,7 +122,7 @@ func TestConfigurePanic(t *testing.T) {
 			t.Errorf("configure did not panic when GetFrom returned an error")
 		}
 	}()
-	configure[TestStruct](vld, &obj, nil)
+	configure[TestStruct](vld, &obj, nil, nil)
 }
 
 func TestBuilder_Slice(t *testing.T) {
@@ -131,7 +135,7 @@ func TestBuilder_Slice(t *testing.T) {
 		PtrInt: 44,
 	}
 	vld := New()
-	bld := configure[*TestStruct](vld, obj, nil)
+	bld := configure[*TestStruct](vld, obj, nil, nil)
 	bld.Slice(&obj.Slice).MaxLen(1)
 	bld.Number(&obj.PtrInt).Max(float32(22.22))
 	err := vld.Validate(context.Background(), obj)
@@ -0,0 +1,120 @@
+package valigo
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	"github.com/insei/fmap/v3"
+
+	"github.com/insei/valigo/num"
+	"github.com/insei/valigo/shared"
+	"github.com/insei/valigo/str"
+)
+
+// sliceElements runs the element rules for each element of the slice field,
+// error locations of the element rules are formatted as Field[index].
+type sliceElements struct {
+	field      fmap.Field
+	v          *Validator
+	elemType   reflect.Type
+	errFn      shared.ConfigErrorFn
+	describeFn shared.DescribeFn
+	fns        shared.FieldValidationFns
+}
+
+// newSliceElements returns sliceElements for the slice field and registers its validation function
+// with the field rules registration r.
+func newSliceElements(v *Validator, r fieldRules) *sliceElements {
+	e := &sliceElements{
+		field: r.field,
+		v:     v,
+		// the placeholder type of the invalid field, its rules are discarded
+		elemType:   reflect.TypeOf((*any)(nil)).Elem(),
+		errFn:      r.errFn,
+		describeFn: r.describeFn.WithScope(shared.RuleScopeItems),
+	}
+	switch {
+	case r.field == nil:
+	case r.field.GetDereferencedType().Kind() != reflect.Slice:
+		r.errFn.Report(shared.NewConfigError(r.field, "type %s is not a slice", r.field.GetType().String()))
+		e.errFn, e.describeFn = shared.DiscardConfigError, nil
+	default:
+		e.elemType = r.field.GetDereferencedType().Elem()
+		r.appendFn(e.validate)
+	}
+	return e
+}
+
+// appendFn appends the element validation function.
+func (e *sliceElements) appendFn(fn shared.FieldValidationFn) {
+	e.fns.Append(fn)
+}
+
+// strings returns str.BaseConfigurator for rules applied to each slice element,
+// slice element should be a string or a pointer to string.
+func (e *sliceElements) strings() str.BaseConfigurator {
+	return str.NewElementConfigurator(str.ElementConfiguratorParams{
+		Type:       e.elemType,
+		Field:      e.field,
+		Helper:     e.v.GetHelper(),
+		AppendFn:   e.appendFn,
+		ErrorFn:    e.errFn,
+		DescribeFn: e.describeFn,
+	})
+}
+
+// numbers returns num.BaseConfigurator for rules applied to each slice element,
+// slice element should be a number or a pointer to number.
+func (e *sliceElements) numbers() num.BaseConfigurator {
+	return num.NewElementConfigurator(num.ElementConfiguratorParams{
+		Type:       e.elemType,
+		Field:      e.field,
+		Helper:     e.v.GetHelper(),
+		AppendFn:   e.appendFn,
+		ErrorFn:    e.errFn,
+		DescribeFn: e.describeFn,
+	})
+}
+
+// requiredStructs appends the rule checking each pointer to struct element is not nil.
+func (e *sliceElements) requiredStructs() {
+	e.describeFn.Describe(shared.Rule{Name: shared.RuleRequired, LocaleKey: structRequiredLocaleKey})
+	e.appendFn(func(ctx context.Context, h shared.Helper, v any) []shared.Error {
+		if _, ok := derefStruct(v); !ok {
+			err := h.ErrorT(ctx, e.field, nil, structRequiredLocaleKey)
+			return []shared.Error{err.WithCode(string(ErrStructRequired))}
+		}
+		return nil
+	})
+}
+
+// validate runs the element rules for each slice element in the index order.
+func (e *sliceElements) validate(ctx context.Context, h shared.Helper, value any) []shared.Error {
+	fns := e.fns.Load()
+	if len(fns) == 0 {
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Slice || rv.Len() == 0 {
+		return nil
+	}
+	prefix := e.v.helper.getFieldLocation(e.field)
+	// elements errors are relocated, so results of the async rules are needed immediately
+	ctx = shared.WithoutAsyncRunner(ctx)
+	var errs []shared.Error
+	for i := 0; i < rv.Len() && ctx.Err() == nil; i++ {
+		elemPtr := rv.Index(i).Addr().Interface()
+		location := prefix + "[" + strconv.Itoa(i) + "]"
+		for _, fn := range fns {
+			errs = append(errs, relocate(fn(ctx, h, elemPtr), location)...)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,90 @@
+package valigo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/insei/valigo/shared"
+)
+
+// ValidationErrors is the validation errors aggregate, it implements the error interface,
+// so the validation result can be returned as one error. The errors are unwrapped with errors.Is and errors.As,
+// i.e. errors.Is(err, str.ErrMaxLen) or errors.As(err, &shared.Error{}).
+type ValidationErrors []shared.Error
+
+// Error implements the error interface, it returns the errors messages separated by "; ".
+func (e ValidationErrors) Error() string {
+	var b strings.Builder
+	for i, err := range e {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the errors for errors.Is and errors.As.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// ByLocation returns the errors with the location, see WithFieldLocationNamingFn.
+func (e ValidationErrors) ByLocation(location string) ValidationErrors {
+	var errs ValidationErrors
+	for _, err := range e {
+		if err.Location == location {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Has checks if there is an error with the code, i.e. "string.max_len", see shared.RuleCode.
+func (e ValidationErrors) Has(code string) bool {
+	for _, err := range e {
+		if err.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// First returns the first error, it returns nil if there are no errors.
+func (e ValidationErrors) First() *shared.Error {
+	if len(e) == 0 {
+		return nil
+	}
+	return &e[0]
+}
+
+// ValidationErrorsTransformer is the errors transformer for WithErrorsTransformer aggregating the errors
+// to the ValidationErrors, so Validate returns one error or nil.
+func ValidationErrorsTransformer(errs []shared.Error) []error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return []error{ValidationErrors(errs)}
+}
+
+// ValidateE is similar to ValidateTyped, but it returns the errors as ValidationErrors
+// or nil if the object is valid.
+func (v *Validator) ValidateE(ctx context.Context, obj any) error {
+	if errs := v.ValidateTyped(ctx, obj); len(errs) > 0 {
+		return ValidationErrors(errs)
+	}
+	return nil
+}
+
+// ValidatePartialE is similar to ValidatePartialTyped, but it returns the errors as ValidationErrors
+// or nil if the selected fields are valid.
+func (v *Validator) ValidatePartialE(ctx context.Context, obj any, fields ...any) error {
+	if errs := v.ValidatePartialTyped(ctx, obj, fields...); len(errs) > 0 {
+		return ValidationErrors(errs)
+	}
+	return nil
+}
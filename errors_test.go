@@ -0,0 +1,68 @@
+package valigo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insei/valigo/num"
+	"github.com/insei/valigo/shared"
+	"github.com/insei/valigo/str"
+)
+
+type testErrorsForm struct {
+	Name string
+	Tags []string
+	Age  int
+}
+
+func newErrorsValidator(opts ...Option) *Validator {
+	v := New(opts...)
+	Configure[testErrorsForm](v, func(c Configurator[testErrorsForm], obj *testErrorsForm) {
+		c.String(&obj.Name).Required().MaxLen(3)
+		c.StringSlice(&obj.Tags).Email()
+		c.Number(&obj.Age).Min(18)
+	})
+	return v
+}
+
+func TestValidateE(t *testing.T) {
+	v := newErrorsValidator()
+	assert.NoError(t, v.ValidateE(context.Background(), &testErrorsForm{Name: "abc", Age: 18}))
+
+	err := v.ValidateE(context.Background(), &testErrorsForm{Name: "abcd", Tags: []string{"tag"}, Age: 18})
+	assert.ErrorIs(t, err, str.ErrMaxLen)
+	assert.ErrorIs(t, err, str.ErrEmail)
+	assert.NotErrorIs(t, err, str.ErrRequired)
+	assert.NotErrorIs(t, err, num.ErrMin)
+
+	var fieldErr shared.Error
+	assert.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "Name", fieldErr.Location)
+
+	var errs ValidationErrors
+	assert.True(t, errors.As(err, &errs))
+	assert.Len(t, errs, 2)
+	assert.True(t, errs.Has("string.email"))
+	assert.False(t, errs.Has("string.required"))
+	assert.Equal(t, ValidationErrors{errs[1]}, errs.ByLocation("Tags"))
+	assert.Equal(t, "string.max_len", errs.First().Code)
+	assert.Equal(t, errs[0].Error()+"; "+errs[1].Error(), err.Error())
+	assert.Nil(t, ValidationErrors(nil).First())
+
+	err = v.ValidatePartialE(context.Background(), &testErrorsForm{Name: "abcd"}, "Age")
+	assert.ErrorIs(t, err, num.ErrMin)
+	assert.NotErrorIs(t, err, str.ErrMaxLen)
+}
+
+func TestValidationErrorsTransformer(t *testing.T) {
+	v := newErrorsValidator(WithErrorsTransformer(ValidationErrorsTransformer))
+	assert.Empty(t, v.Validate(context.Background(), &testErrorsForm{Name: "abc", Age: 18}))
+
+	errs := v.Validate(context.Background(), &testErrorsForm{Age: 18})
+	assert.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], str.ErrRequired)
+	assert.ErrorIs(t, errs[0], shared.CodeError("string.required"))
+}
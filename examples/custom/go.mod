@@ -5,6 +5,7 @@ go 1.22
 require github.com/insei/valigo v1.0.0
 
 require (
+	github.com/google/uuid v1.6.0 // indirect
 	github.com/insei/fmap/v3 v3.1.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -27,12 +27,12 @@ func main() {
 			return nil
 		})
 
-		//Custom on field
-		c.String(&obj.LastName).
+		//Custom on field, the value type is inferred from the field type
+		valigo.Str(c, &obj.LastName).
 			Custom(func(ctx context.Context, h *shared.FieldCustomHelper, value *string) []shared.Error {
 				if *value != "Rebecca" {
 					localeKey := "Only Rebecca is allowed" // you can add translations if you want, see translation example
-					return []shared.Error{h.ErrorT(ctx, value, localeKey)}
+					return []shared.Error{h.ErrorT(ctx, *value, localeKey)}
 				}
 				return nil
 			})
@@ -0,0 +1,45 @@
+package valigo
+
+import (
+	"context"
+	"slices"
+)
+
+// groupsCtxKey is a context key of the validation groups selected for the validation.
+type groupsCtxKey struct{}
+
+// groups is a list of the validation groups selected for the validation.
+type groups []string
+
+// containsAll checks if all the groups are selected.
+func (g groups) containsAll(required []string) bool {
+	for _, group := range required {
+		if !slices.Contains(g, group) {
+			return false
+		}
+	}
+	return true
+}
+
+// groupsFromContext returns the validation groups selected in the ctx.
+func groupsFromContext(ctx context.Context) groups {
+	g, _ := ctx.Value(groupsCtxKey{}).(groups)
+	return g
+}
+
+// ContextWithGroups returns a copy of the ctx with the validation groups selected for the validation.
+// Rules configured in the Configurator.Group run only if the group is selected,
+// rules outside any group always run. Groups selected in the parent ctx are kept.
+func ContextWithGroups(ctx context.Context, names ...string) context.Context {
+	selected := groupsFromContext(ctx)
+	g := make(groups, 0, len(selected)+len(names))
+	g = append(append(g, selected...), names...)
+	return context.WithValue(ctx, groupsCtxKey{}, g)
+}
+
+// Group configures the rules of the validation group with the name,
+// the rules run only if the group is selected with ContextWithGroups.
+// Rules of the nested groups run only if all the groups are selected.
+func (b *builder[T]) Group(name string, fn func(c Configurator[T])) {
+	fn(newBuilder[T](b.v, b.obj, b.batch, b.cond.withGroup(name), b.errFn))
+}
@@ -0,0 +1,99 @@
+package valigo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type account struct {
+	ID       string
+	Name     string
+	Password string
+}
+
+func TestBuilderGroup(t *testing.T) {
+	v := New()
+	Configure[account](v, func(c Configurator[account], obj *account) {
+		c.String(&obj.Name).Required()
+		c.Group("create", func(c Configurator[account]) {
+			c.String(&obj.Password).Required()
+		})
+		c.Group("update", func(c Configurator[account]) {
+			c.String(&obj.ID).Required()
+			c.Group("admin", func(c Configurator[account]) {
+				c.String(&obj.Password).Required()
+			})
+			c.When(func(ctx context.Context, obj *account) bool {
+				return obj.Name == "root"
+			}).String(&obj.Password).Required()
+		})
+	})
+
+	testCases := []struct {
+		name      string
+		groups    []string
+		obj       *account
+		locations []string
+	}{
+		{
+			name:      "no groups",
+			obj:       &account{},
+			locations: []string{"Name"},
+		},
+		{
+			name:      "create",
+			groups:    []string{"create"},
+			obj:       &account{},
+			locations: []string{"Name", "Password"},
+		},
+		{
+			name:      "update",
+			groups:    []string{"update"},
+			obj:       &account{},
+			locations: []string{"Name", "ID"},
+		},
+		{
+			name:      "update with condition",
+			groups:    []string{"update"},
+			obj:       &account{Name: "root"},
+			locations: []string{"ID", "Password"},
+		},
+		{
+			name:      "nested group",
+			groups:    []string{"update", "admin"},
+			obj:       &account{},
+			locations: []string{"Name", "ID", "Password"},
+		},
+		{
+			name:      "nested group without parent",
+			groups:    []string{"admin"},
+			obj:       &account{},
+			locations: []string{"Name"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.groups != nil {
+				ctx = ContextWithGroups(ctx, tc.groups...)
+			}
+			errs := v.ValidateTyped(ctx, tc.obj)
+			var locations []string
+			for _, err := range errs {
+				locations = append(locations, err.Location)
+			}
+			assert.Equal(t, tc.locations, locations)
+		})
+	}
+}
+
+func TestContextWithGroups(t *testing.T) {
+	ctx := ContextWithGroups(context.Background(), "create")
+	ctx = ContextWithGroups(ctx, "admin")
+	assert.Equal(t, groups{"create", "admin"}, groupsFromContext(ctx))
+	assert.True(t, groupsFromContext(ctx).containsAll([]string{"admin", "create"}))
+	assert.False(t, groupsFromContext(ctx).containsAll([]string{"update"}))
+	assert.Nil(t, groupsFromContext(context.Background()))
+}
@@ -15,18 +15,24 @@ type helper struct {
 	getFieldLocation func(field fmap.Field) string
 }
 
-// ErrorT returns a shared.Error with the given location, message, and value.
+// ErrorT returns a shared.Error with the given location, message, value and locale key.
 // The message is translated using the translator.
 func (h *helper) ErrorT(ctx context.Context, field fmap.Field, value any, localeKey string, args ...any) shared.Error {
 	location := h.getFieldLocation(field)
 	msg := h.t.T(ctx, localeKey, args...)
 	return shared.Error{
-		Location: location,
-		Message:  msg,
-		Value:    value,
+		Location:  location,
+		Message:   msg,
+		Value:     value,
+		LocaleKey: localeKey,
 	}
 }
 
+// FieldLocation returns the location of the field used in errors.
+func (h *helper) FieldLocation(field fmap.Field) string {
+	return h.getFieldLocation(field)
+}
+
 // newHelper returns a new helper with a default translator and getFieldLocation function.
 func newHelper() *helper {
 	return &helper{
@@ -0,0 +1,408 @@
+// Package http decodes and validates the JSON requests bodies and writes the errors as the problem details.
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	nethttp "net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/insei/valigo"
+	"github.com/insei/valigo/problem"
+	"github.com/insei/valigo/shared"
+)
+
+const (
+	// DefaultMaxBodySize is the default limit of the request body size.
+	DefaultMaxBodySize = 1 << 20
+
+	// StatusClientClosedRequest is the non-standard status code of the request canceled by the client.
+	StatusClientClosedRequest = 499
+
+	// codePrefix is the prefix of the decoding errors codes.
+	codePrefix = "json."
+
+	syntaxLocaleKey       = "validation:json:Invalid JSON"
+	emptyBodyLocaleKey    = "validation:json:Request body is empty"
+	bodyTooLargeLocaleKey = "validation:json:Request body is larger than %d bytes"
+	typeLocaleKey         = "validation:json:Should be %s"
+	unknownFieldLocaleKey = "validation:json:Unknown field"
+)
+
+// Sentinel errors of the request body decoding, errors.Is reports whether the error returned by DecodeAndValidate
+// has the decoding error.
+var (
+	ErrSyntax       = shared.CodeError(codePrefix + "syntax")
+	ErrEmptyBody    = shared.CodeError(codePrefix + "empty_body")
+	ErrBodyTooLarge = shared.CodeError(codePrefix + "body_too_large")
+	ErrType         = shared.CodeError(codePrefix + "type")
+	ErrUnknownField = shared.CodeError(codePrefix + "unknown_field")
+)
+
+// errTrailingData is the error of the request body with the data after the JSON value.
+var errTrailingData = errors.New("json: invalid data after top-level value")
+
+// unmarshalerType is the type of the json.Unmarshaler interface.
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// options are the options of the request body decoding.
+type options struct {
+	maxBodySize           int64
+	disallowUnknownFields bool
+}
+
+// Option is an interface that defines a method for applying options to the request body decoding.
+type Option interface {
+	apply(o *options)
+}
+
+// optionFunc is a function type that implements the Option interface.
+type optionFunc func(o *options)
+
+// apply implements the Option interface for optionFunc.
+func (f optionFunc) apply(o *options) {
+	f(o)
+}
+
+// WithMaxBodySize returns an Option that limits the request body size, DefaultMaxBodySize by default.
+// Zero or negative size means no limit.
+func WithMaxBodySize(size int64) Option {
+	return optionFunc(func(o *options) {
+		o.maxBodySize = size
+	})
+}
+
+// WithDisallowUnknownFields returns an Option that rejects the request body objects with the unknown fields.
+func WithDisallowUnknownFields() Option {
+	return optionFunc(func(o *options) {
+		o.disallowUnknownFields = true
+	})
+}
+
+// DecodeAndValidate decodes the JSON request body to the T and validates it with the rules registered for the T
+// in the request context, see valigo.Validator.ValidateE. The decoding errors (invalid JSON, unexpected value types,
+// unknown fields, too large body, data after the JSON value) are returned as valigo.ValidationErrors with the field
+// locations and the messages translated with the validator translator, so they are handled the same way
+// as the validation errors. The w is used to limit the request body size, see http.MaxBytesReader.
+// The other errors, i.e. the body reading errors, are returned as is.
+func DecodeAndValidate[T any](v *valigo.Validator, w nethttp.ResponseWriter, r *nethttp.Request, opts ...Option) (*T, error) {
+	o := options{maxBodySize: DefaultMaxBodySize}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.apply(&o)
+		}
+	}
+	body := r.Body
+	if o.maxBodySize > 0 {
+		body = nethttp.MaxBytesReader(w, body, o.maxBodySize)
+	}
+	// the body is read at once to locate the unknown fields
+	data, err := io.ReadAll(body)
+	if err != nil {
+		var maxBytesErr *nethttp.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			decodeErr, _ := newDecodeError(r.Context(), v, nil, nil, err)
+			return nil, valigo.ValidationErrors{decodeErr}
+		}
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if o.disallowUnknownFields {
+		dec.DisallowUnknownFields()
+	}
+	obj := new(T)
+	err = dec.Decode(obj)
+	if err == nil {
+		// the body should contain the single JSON value
+		if err = dec.Decode(&json.RawMessage{}); err == nil {
+			err = errTrailingData
+		} else if errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+	if err != nil {
+		if decodeErr, ok := newDecodeError(r.Context(), v, reflect.TypeOf(obj).Elem(), data, err); ok {
+			return nil, valigo.ValidationErrors{decodeErr}
+		}
+		return nil, err
+	}
+	if err := v.ValidateE(r.Context(), obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// newDecodeError converts the JSON decoding error of the data to the validation error located at the field
+// of the type t, it returns false if the error is not the decoding error.
+func newDecodeError(ctx context.Context, v *valigo.Validator, t reflect.Type, data []byte, err error) (shared.Error, bool) {
+	var (
+		syntaxErr   *json.SyntaxError
+		typeErr     *json.UnmarshalTypeError
+		maxBytesErr *nethttp.MaxBytesError
+		e           shared.Error
+	)
+	switch {
+	case errors.As(err, &maxBytesErr):
+		e = shared.Error{LocaleKey: bodyTooLargeLocaleKey}.WithCode(string(ErrBodyTooLarge), shared.Param{Name: "limit", Value: maxBytesErr.Limit})
+		e.Message = v.GetTranslator().T(ctx, e.LocaleKey, maxBytesErr.Limit)
+	case errors.As(err, &syntaxErr):
+		e = shared.Error{LocaleKey: syntaxLocaleKey}.WithCode(string(ErrSyntax), shared.Param{Name: "offset", Value: syntaxErr.Offset})
+		e.Message = v.GetTranslator().T(ctx, e.LocaleKey)
+	case errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, errTrailingData):
+		e = shared.Error{LocaleKey: syntaxLocaleKey}.WithCode(string(ErrSyntax))
+		e.Message = v.GetTranslator().T(ctx, e.LocaleKey)
+	case errors.Is(err, io.EOF):
+		e = shared.Error{LocaleKey: emptyBodyLocaleKey}.WithCode(string(ErrEmptyBody))
+		e.Message = v.GetTranslator().T(ctx, e.LocaleKey)
+	case errors.As(err, &typeErr):
+		expected := jsonType(typeErr.Type)
+		e = shared.Error{LocaleKey: typeLocaleKey, Value: typeErr.Value}.WithCode(string(ErrType), shared.Param{Name: "expected", Value: expected})
+		e.Message = v.GetTranslator().T(ctx, e.LocaleKey, expected)
+		e.Location = fieldLocation(v, t, typeErr.Field)
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		// the unknown field error of the json.Decoder has no type
+		name, unquoteErr := strconv.Unquote(strings.TrimPrefix(err.Error(), "json: unknown field "))
+		if unquoteErr != nil {
+			return shared.Error{}, false
+		}
+		e = shared.Error{LocaleKey: unknownFieldLocaleKey, Location: unknownFieldLocation(v, t, data, name)}.WithCode(string(ErrUnknownField))
+		e.Message = v.GetTranslator().T(ctx, e.LocaleKey)
+	default:
+		return shared.Error{}, false
+	}
+	return e, true
+}
+
+// unknownFieldLocation returns the location of the unknown field with the name in the data decoded to the type t,
+// i.e. "Lines[0].other" with the default field location naming. The name is returned if the field is not found.
+func unknownFieldLocation(v *valigo.Validator, t reflect.Type, data []byte, name string) string {
+	path, found, _ := findUnknownField(json.NewDecoder(bytes.NewReader(data)), t, "", name)
+	if !found {
+		return name
+	}
+	i := strings.LastIndex(path, ".")
+	if i < 0 {
+		return name
+	}
+	return fieldLocation(v, t, path[:i]) + "." + name
+}
+
+// findUnknownField walks the next JSON value of the dec decoded to the type t and returns the JSON path
+// of the first object key with the name which is not a field of the struct type, i.e. "lines.0.other".
+// The values of the types other than structs, slices, arrays and maps are not walked.
+func findUnknownField(dec *json.Decoder, t reflect.Type, path, name string) (string, bool, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return "", false, err
+	}
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t != nil && reflect.PointerTo(t).Implements(unmarshalerType) {
+		// the values decoded by the type itself are never checked for the unknown fields
+		t = nil
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return "", false, nil
+	}
+	var elem reflect.Type
+	if t != nil && (delim == '[' && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) ||
+		delim == '{' && t.Kind() == reflect.Map) {
+		elem = t.Elem()
+	}
+	for i := 0; dec.More(); i++ {
+		elemPath := strconv.Itoa(i)
+		elemType := elem
+		if delim == '{' {
+			key, err := dec.Token()
+			if err != nil {
+				return "", false, err
+			}
+			elemPath, _ = key.(string)
+			if t != nil && t.Kind() == reflect.Struct {
+				f, ok := jsonField(t, elemPath)
+				if !ok && elemPath == name {
+					return joinPath(path, elemPath), true, nil
+				}
+				elemType = f.Type
+			}
+		}
+		if p, found, err := findUnknownField(dec, elemType, joinPath(path, elemPath), name); found || err != nil {
+			return p, found, err
+		}
+	}
+	// the closing delimiter
+	_, err = dec.Token()
+	return "", false, err
+}
+
+// joinPath joins the JSON path with the object key or the array index.
+func joinPath(path, segment string) string {
+	if path == "" {
+		return segment
+	}
+	return path + "." + segment
+}
+
+// jsonType returns the JSON type of the values of the Go type t.
+func jsonType(t reflect.Type) string {
+	if t == nil {
+		return "null"
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	case reflect.Ptr:
+		return jsonType(t.Elem())
+	}
+	return t.String()
+}
+
+// fieldLocation returns the location of the field of the struct type t by the JSON path of the decoding error,
+// i.e. "lines.0.qty" is "Lines[0].Qty" with the default field location naming.
+// The JSON path is returned if the field is not found.
+func fieldLocation(v *valigo.Validator, t reflect.Type, path string) string {
+	var (
+		location, structPath string
+		owner                reflect.Type
+	)
+	// flush appends the location of the struct field (or nested struct field) of the owner
+	flush := func() bool {
+		if owner == nil {
+			return true
+		}
+		loc, ok := v.FieldLocation(owner, structPath)
+		if !ok {
+			return false
+		}
+		if location != "" {
+			loc = location + "." + loc
+		}
+		location, owner, structPath = loc, nil, ""
+		return true
+	}
+	for _, segment := range strings.Split(path, ".") {
+		if t.Kind() == reflect.Ptr {
+			// the fields of the pointers to nested structs are located relative to the pointer field
+			if !flush() {
+				return path
+			}
+			for t.Kind() == reflect.Ptr {
+				t = t.Elem()
+			}
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			f, ok := jsonField(t, segment)
+			if !ok {
+				return path
+			}
+			if owner == nil {
+				owner, structPath = t, f.Name
+			} else {
+				structPath += "." + f.Name
+			}
+			t = f.Type
+		case reflect.Slice, reflect.Array, reflect.Map:
+			// the elements are located by the index or key
+			if !flush() {
+				return path
+			}
+			location += "[" + segment + "]"
+			t = t.Elem()
+		default:
+			return path
+		}
+	}
+	if !flush() {
+		return path
+	}
+	return location
+}
+
+// jsonField returns the exported field of the struct type t with the JSON name,
+// the names are matched case-insensitively the same way as in encoding/json.
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	var (
+		match reflect.StructField
+		found bool
+	)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		fieldName, _, _ := strings.Cut(tag, ",")
+		if fieldName == "" {
+			fieldName = f.Name
+		}
+		if fieldName == name {
+			return f, true
+		}
+		if !found && strings.EqualFold(fieldName, name) {
+			match, found = f, true
+		}
+	}
+	return match, found
+}
+
+// WriteErrors writes the error returned by DecodeAndValidate to the w as the problem details translated
+// in the languages of the request context, see problem.Write. The status code is
+// http.StatusRequestEntityTooLarge for too large body, http.StatusBadRequest for the other decoding errors and
+// http.StatusUnprocessableEntity for the validation errors. The validation interrupted by the request context
+// is not the client input error, its status code is StatusClientClosedRequest for the canceled request,
+// http.StatusServiceUnavailable for the exceeded deadline and http.StatusInternalServerError otherwise.
+// The validator v translates the problem title and detail.
+// The other errors are written as the internal server error and returned, i.e. to be logged by the caller.
+// The nil error is not written.
+func WriteErrors(w nethttp.ResponseWriter, r *nethttp.Request, v *valigo.Validator, err error, opts ...problem.Option) error {
+	if err == nil {
+		return nil
+	}
+	var errs valigo.ValidationErrors
+	if !errors.As(err, &errs) {
+		nethttp.Error(w, nethttp.StatusText(nethttp.StatusInternalServerError), nethttp.StatusInternalServerError)
+		return err
+	}
+	return problem.Write(w, r, v, errs, append([]problem.Option{problem.WithStatus(errorsStatus(errs))}, opts...)...)
+}
+
+// errorsStatus returns the status code of the errors returned by DecodeAndValidate.
+func errorsStatus(errs valigo.ValidationErrors) int {
+	status := nethttp.StatusUnprocessableEntity
+	for _, e := range errs {
+		switch {
+		case errors.Is(e, shared.ErrValidationInterrupted) && errors.Is(e, context.Canceled):
+			return StatusClientClosedRequest
+		case errors.Is(e, shared.ErrValidationInterrupted) && errors.Is(e, context.DeadlineExceeded):
+			return nethttp.StatusServiceUnavailable
+		case errors.Is(e, shared.ErrValidationInterrupted):
+			return nethttp.StatusInternalServerError
+		case e.Code == string(ErrBodyTooLarge):
+			status = nethttp.StatusRequestEntityTooLarge
+		case strings.HasPrefix(e.Code, codePrefix):
+			status = nethttp.StatusBadRequest
+		}
+	}
+	return status
+}
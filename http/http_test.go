@@ -0,0 +1,173 @@
+package http
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/insei/fmap/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insei/valigo"
+	"github.com/insei/valigo/shared"
+	"github.com/insei/valigo/str"
+	"github.com/insei/valigo/translator"
+)
+
+type Line struct {
+	Qty int `json:"qty"`
+}
+
+type Address struct {
+	City string `json:"city"`
+}
+
+type Order struct {
+	Name    string          `json:"name"`
+	Lines   []Line          `json:"lines"`
+	ByName  map[string]Line `json:"byName"`
+	Address *Address        `json:"address"`
+}
+
+func newValidator(opts ...valigo.Option) *valigo.Validator {
+	v := valigo.New(opts...)
+	valigo.Configure[Order](v, func(c valigo.Configurator[Order], obj *Order) {
+		c.String(&obj.Name).Required().MaxLen(5)
+	})
+	return v
+}
+
+func newRequest(body string) *nethttp.Request {
+	return httptest.NewRequest(nethttp.MethodPost, "/orders", strings.NewReader(body))
+}
+
+func TestDecodeAndValidate(t *testing.T) {
+	v := newValidator()
+	order, err := DecodeAndValidate[Order](v, httptest.NewRecorder(), newRequest(`{"name": "order", "lines": [{"qty": 1}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &Order{Name: "order", Lines: []Line{{Qty: 1}}}, order)
+
+	_, err = DecodeAndValidate[Order](v, httptest.NewRecorder(), newRequest(`{"name": "order 1"}`))
+	assert.ErrorIs(t, err, str.ErrMaxLen)
+}
+
+func TestDecodeAndValidateDecodeErrors(t *testing.T) {
+	v := newValidator()
+	tests := []struct {
+		name     string
+		body     string
+		opts     []Option
+		sentinel error
+		location string
+		message  string
+	}{
+		{name: "syntax", body: `{"name": }`, sentinel: ErrSyntax, message: "Invalid JSON"},
+		{name: "unexpected end", body: `{"name": `, sentinel: ErrSyntax, message: "Invalid JSON"},
+		{name: "empty", body: ``, sentinel: ErrEmptyBody, message: "Request body is empty"},
+		{name: "type", body: `{"name": 1}`, sentinel: ErrType, location: "Name", message: "Should be string"},
+		{name: "slice element type", body: `{"lines": [{"qty": "1"}]}`, sentinel: ErrType, location: "Lines[0].Qty", message: "Should be number"},
+		{name: "map value type", body: `{"byName": {"a": {"qty": true}}}`, sentinel: ErrType, location: "ByName[a].Qty", message: "Should be number"},
+		{name: "nested type", body: `{"address": {"city": []}}`, sentinel: ErrType, location: "Address.City", message: "Should be string"},
+		{name: "trailing value", body: `{"name": "order"} {}`, sentinel: ErrSyntax, message: "Invalid JSON"},
+		{name: "trailing data", body: `{"name": "order"}}`, sentinel: ErrSyntax, message: "Invalid JSON"},
+		{name: "unknown field", body: `{"other": 1}`, opts: []Option{WithDisallowUnknownFields()}, sentinel: ErrUnknownField, location: "other", message: "Unknown field"},
+		{name: "slice element unknown field", body: `{"lines": [{"qty": 1}, {"other": 1}]}`, opts: []Option{WithDisallowUnknownFields()}, sentinel: ErrUnknownField, location: "Lines[1].other", message: "Unknown field"},
+		{name: "nested unknown field", body: `{"other": {}, "address": {"other": 1}}`, opts: []Option{WithDisallowUnknownFields()}, sentinel: ErrUnknownField, location: "other", message: "Unknown field"},
+		{name: "pointer unknown field", body: `{"address": {"city": "a", "other": 1}}`, opts: []Option{WithDisallowUnknownFields()}, sentinel: ErrUnknownField, location: "Address.other", message: "Unknown field"},
+		{name: "too large", body: `{"name": "order"}`, opts: []Option{WithMaxBodySize(4)}, sentinel: ErrBodyTooLarge, message: "Request body is larger than 4 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeAndValidate[Order](v, httptest.NewRecorder(), newRequest(tt.body), tt.opts...)
+			assert.ErrorIs(t, err, tt.sentinel)
+			var errs valigo.ValidationErrors
+			assert.True(t, errors.As(err, &errs))
+			assert.Equal(t, tt.location, errs.First().Location)
+			assert.Equal(t, tt.message, errs.First().Message)
+		})
+	}
+}
+
+func TestDecodeAndValidateLocationNaming(t *testing.T) {
+	v := newValidator(valigo.WithFieldLocationNamingFn(func(field fmap.Field) string {
+		return field.GetTagPath("json", false)
+	}))
+	_, err := DecodeAndValidate[Order](v, httptest.NewRecorder(), newRequest(`{"lines": [{"qty": "1"}]}`))
+	var errs valigo.ValidationErrors
+	assert.True(t, errors.As(err, &errs))
+	assert.Equal(t, "lines[0].qty", errs.First().Location)
+}
+
+func TestWriteErrors(t *testing.T) {
+	v := newValidator()
+	handler := translator.NewAcceptLanguageMiddleware()(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		_, err := DecodeAndValidate[Order](v, w, r)
+		assert.NoError(t, WriteErrors(w, r, v, err))
+	}))
+	tests := []struct {
+		body     string
+		status   int
+		expected string
+	}{
+		{
+			body:   `{"name": "order 1"}`,
+			status: nethttp.StatusUnprocessableEntity,
+			expected: `{
+				"title": "Ошибка валидации",
+				"status": 422,
+				"detail": "Количество ошибок валидации: 1",
+				"errors": [{"location": "Name", "code": "string.max_len", "message": "Не может быть длиннее 5 символов", "params": {"max": 5}}]
+			}`,
+		},
+		{
+			body:   `{"name": 1}`,
+			status: nethttp.StatusBadRequest,
+			expected: `{
+				"title": "Ошибка валидации",
+				"status": 400,
+				"detail": "Количество ошибок валидации: 1",
+				"errors": [{"location": "Name", "code": "json.type", "message": "Должно быть string", "params": {"expected": "string"}}]
+			}`,
+		},
+	}
+	for _, tt := range tests {
+		r := newRequest(tt.body)
+		r.Header.Set("Accept-Language", "ru")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, tt.status, w.Code)
+		assert.JSONEq(t, tt.expected, w.Body.String())
+	}
+
+	w := httptest.NewRecorder()
+	readErr := errors.New("read error")
+	assert.ErrorIs(t, WriteErrors(w, newRequest(""), v, readErr), readErr)
+	assert.Equal(t, nethttp.StatusInternalServerError, w.Code)
+}
+
+func TestWriteErrorsInterrupted(t *testing.T) {
+	v := newValidator()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithDeadline(context.Background(), time.Now())
+	defer cancel()
+	tests := []struct {
+		ctx    context.Context
+		status int
+	}{
+		{ctx: canceled, status: StatusClientClosedRequest},
+		{ctx: expired, status: nethttp.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		r := newRequest(`{"name": "order 1"}`).WithContext(tt.ctx)
+		w := httptest.NewRecorder()
+		_, err := DecodeAndValidate[Order](v, w, r)
+		assert.ErrorIs(t, err, shared.ErrValidationInterrupted)
+		assert.NoError(t, WriteErrors(w, r, v, err))
+		assert.Equal(t, tt.status, w.Code)
+	}
+}
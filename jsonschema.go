@@ -0,0 +1,498 @@
+package valigo
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	guuid "github.com/google/uuid"
+	"github.com/insei/fmap/v3"
+
+	"github.com/insei/valigo/shared"
+)
+
+// JSONSchemaDialect is the JSON Schema dialect of the schemas generated by the Validator.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema is a JSON Schema (Draft 2020-12) describing the type and the rules registered for it.
+// The rules without JSON Schema keywords, i.e. custom, cross-field and conditional rules
+// (enabled with When or Group), are listed in the "x-valigo-rules" annotation.
+// The strings length rules (MinLen, MaxLen) count bytes, so they are listed in the annotation too,
+// the characters count rules (MinRunes, MaxRunes) are the minLength and maxLength keywords.
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 any                    `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	Enum                 []any                  `json:"enum,omitempty"`
+	Default              any                    `json:"default,omitempty"`
+	MinLength            *int                   `json:"minLength,omitempty"`
+	MaxLength            *int                   `json:"maxLength,omitempty"`
+	Minimum              any                    `json:"minimum,omitempty"`
+	Maximum              any                    `json:"maximum,omitempty"`
+	ExclusiveMinimum     any                    `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     any                    `json:"exclusiveMaximum,omitempty"`
+	MinItems             *int                   `json:"minItems,omitempty"`
+	MaxItems             *int                   `json:"maxItems,omitempty"`
+	UniqueItems          bool                   `json:"uniqueItems,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	MinProperties        *int                   `json:"minProperties,omitempty"`
+	MaxProperties        *int                   `json:"maxProperties,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+	PropertyNames        *JSONSchema            `json:"propertyNames,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Defs                 map[string]*JSONSchema `json:"$defs,omitempty"`
+	Rules                []JSONSchemaRule       `json:"x-valigo-rules,omitempty"`
+}
+
+// JSONSchemaRule is a rule without JSON Schema keywords, see shared.Rule.
+type JSONSchemaRule struct {
+	Name        string           `json:"name"`
+	Params      []any            `json:"params,omitempty"`
+	Scope       shared.RuleScope `json:"scope,omitempty"`
+	Conditional bool             `json:"conditional,omitempty"`
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// JSONSchema returns the JSON Schema (Draft 2020-12) of the struct or pointer to struct type t
+// generated from the rules registered for the type. The nested struct types validated
+// with their own rules are placed to the "$defs" and referenced by the type name.
+// The property names are the field locations, see WithFieldLocationNamingFn.
+func (v *Validator) JSONSchema(t reflect.Type) (*JSONSchema, error) {
+	g := newSchemaGenerator(v, "#/$defs/")
+	s, err := g.rootSchema(t)
+	if err != nil {
+		return nil, err
+	}
+	s.Schema = JSONSchemaDialect
+	if len(g.defs) > 0 {
+		s.Defs = g.defs
+	}
+	return s, nil
+}
+
+// JSONSchemas returns the JSON Schemas of the struct or pointer to struct types and the nested struct types
+// validated with their own rules by the type names. The references are prefixed with the refPrefix,
+// i.e. "#/components/schemas/" for the OpenAPI components.
+func (v *Validator) JSONSchemas(refPrefix string, types ...reflect.Type) (map[string]*JSONSchema, error) {
+	g := newSchemaGenerator(v, refPrefix)
+	for _, t := range types {
+		t, err := g.v.structType(t)
+		if err != nil {
+			return nil, err
+		}
+		if t.Name() == "" {
+			return nil, fmt.Errorf("type %s is an anonymous struct", t.String())
+		}
+		g.ref(reflect.PointerTo(t))
+	}
+	return g.defs, nil
+}
+
+// ConfiguredTypes returns the pointer to struct types with the registered rules sorted by the type names.
+// The types configured from the struct tags are registered on the first validation or with ConfigureFromTags.
+func (v *Validator) ConfiguredTypes() []reflect.Type {
+	r := v.storage.load()
+	types := make([]reflect.Type, 0, len(r.plans))
+	for t := range r.plans {
+		if t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct {
+			types = append(types, t)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].String() < types[j].String()
+	})
+	return types
+}
+
+// schemaGenerator generates the JSON Schemas of the types from the rules descriptors.
+type schemaGenerator struct {
+	v         *Validator
+	refPrefix string
+	// defs are the schemas of the referenced types by names.
+	defs map[string]*JSONSchema
+	// names are the names of the referenced types.
+	names map[reflect.Type]string
+}
+
+func newSchemaGenerator(v *Validator, refPrefix string) *schemaGenerator {
+	return &schemaGenerator{
+		v:         v,
+		refPrefix: refPrefix,
+		defs:      make(map[string]*JSONSchema),
+		names:     make(map[reflect.Type]string),
+	}
+}
+
+// rootSchema returns the schema of the struct or pointer to struct type t.
+func (g *schemaGenerator) rootSchema(t reflect.Type) (*JSONSchema, error) {
+	t, err := g.v.structType(t)
+	if err != nil {
+		return nil, err
+	}
+	return g.typeRulesSchema(reflect.PointerTo(t)), nil
+}
+
+// ref returns the reference to the schema of the pointer to struct type t, the schema is added to the defs.
+func (g *schemaGenerator) ref(t reflect.Type) *JSONSchema {
+	name, ok := g.names[t]
+	if !ok {
+		name = t.Elem().Name()
+		if _, exists := g.defs[name]; exists {
+			name = strings.NewReplacer("/", "_", ".", "_").Replace(t.Elem().PkgPath()) + "_" + name
+		}
+		g.names[t] = name
+		// the placeholder breaks the recursion of the self-referencing types
+		g.defs[name] = &JSONSchema{}
+		g.defs[name] = g.typeRulesSchema(t)
+	}
+	return &JSONSchema{Ref: g.refPrefix + name}
+}
+
+// typeRulesSchema returns the schema of the pointer to struct type t with the rules registered for the type.
+func (g *schemaGenerator) typeRulesSchema(t reflect.Type) *JSONSchema {
+	descriptors := g.v.storage.getDescriptors(t)
+	s := g.objectSchema(t, "", descriptors)
+	for _, d := range descriptors {
+		if d.field == nil {
+			s.Rules = append(s.Rules, JSONSchemaRule{Name: d.rule.Name, Params: d.rule.Params, Conditional: d.cond != nil})
+		}
+	}
+	if t.Implements(validatableType) || t.Implements(validatableWithHelperType) {
+		s.Rules = append(s.Rules, JSONSchemaRule{Name: shared.RuleCustom})
+	}
+	return s
+}
+
+// objectSchema returns the schema of the struct fields of the pointer to struct type owner with the struct paths
+// prefix, i.e. "" for the owner type fields or "Address." for the nested fields of the Address field.
+// The descriptors are the rules descriptors of the owner type, nil for the shape only schemas.
+func (g *schemaGenerator) objectSchema(owner reflect.Type, prefix string, descriptors []descriptor) *JSONSchema {
+	s := &JSONSchema{Type: "object"}
+	fields, err := getFields(reflect.New(owner.Elem()).Interface())
+	if err != nil {
+		return s
+	}
+	parentLocation := ""
+	if prefix != "" {
+		parentLocation = g.v.helper.getFieldLocation(fields.MustFind(strings.TrimSuffix(prefix, "."))) + "."
+	}
+	for _, path := range fields.GetAllPaths() {
+		if !strings.HasPrefix(path, prefix) || strings.Contains(path[len(prefix):], ".") {
+			continue
+		}
+		field := fields.MustFind(path)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.TrimPrefix(g.v.helper.getFieldLocation(field), parentLocation)
+		if name == "" {
+			// the fields without locations, i.e. without json tags, are not serialized
+			continue
+		}
+		if s.Properties == nil {
+			s.Properties = make(map[string]*JSONSchema)
+		}
+		prop := g.fieldSchema(owner, field, descriptors)
+		for _, d := range descriptors {
+			// the normalization rules don't constrain the values, the default values are published only
+			if d.field == nil || d.field.GetStructPath() != path || (d.rule.Normalize != nil && !isDefaultValue(d.rule)) {
+				continue
+			}
+			if applyRule(prop, d) {
+				s.Required = appendUnique(s.Required, name)
+			}
+		}
+		s.Properties[name] = prop
+	}
+	return s
+}
+
+// fieldSchema returns the schema of the field of the pointer to struct type owner without the field rules.
+func (g *schemaGenerator) fieldSchema(owner reflect.Type, field fmap.Field, descriptors []descriptor) *JSONSchema {
+	t := field.GetType()
+	if field.GetDereferencedType().Kind() != reflect.Struct || field.GetDereferencedType() == timeType {
+		return g.typeSchema(t)
+	}
+	path := field.GetStructPath()
+	var nested bool
+	for _, d := range descriptors {
+		if d.field != nil && strings.HasPrefix(d.field.GetStructPath(), path+".") {
+			nested = true
+			break
+		}
+	}
+	validated := g.isValidated(owner, field)
+	var s *JSONSchema
+	switch {
+	case nested:
+		// the rules of the nested fields configured for the owner type
+		s = g.objectSchema(owner, path+".", descriptors)
+		if validated {
+			s.Ref = g.ref(reflect.PointerTo(field.GetDereferencedType())).Ref
+		}
+	case validated:
+		s = g.ref(reflect.PointerTo(field.GetDereferencedType()))
+	default:
+		s = g.objectSchema(owner, path+".", nil)
+	}
+	return nullable(s, t.Kind() == reflect.Ptr)
+}
+
+// isValidated checks if the nested struct field of the owner type is validated with the rules of the field type,
+// the anonymous structs are not referenced.
+func (g *schemaGenerator) isValidated(owner reflect.Type, field fmap.Field) bool {
+	return field.GetDereferencedType().Name() != "" && g.v.isNestedValidated(owner, field)
+}
+
+// typeSchema returns the schema of the type t without rules, the struct types are referenced
+// if they are validated with their own rules.
+func (g *schemaGenerator) typeSchema(t reflect.Type) *JSONSchema {
+	isPtr := t.Kind() == reflect.Ptr
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	var s *JSONSchema
+	switch {
+	case t == uuidType:
+		s = &JSONSchema{Type: "string", Format: "uuid"}
+	case t == timeType:
+		s = &JSONSchema{Type: "string", Format: "date-time"}
+	default:
+		switch t.Kind() {
+		case reflect.String:
+			s = &JSONSchema{Type: "string"}
+		case reflect.Bool:
+			s = &JSONSchema{Type: "boolean"}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			s = &JSONSchema{Type: "integer"}
+		case reflect.Float32, reflect.Float64:
+			s = &JSONSchema{Type: "number"}
+		case reflect.Slice, reflect.Array:
+			s = &JSONSchema{Type: "array", Items: g.typeSchema(t.Elem())}
+		case reflect.Map:
+			s = &JSONSchema{Type: "object", AdditionalProperties: g.typeSchema(t.Elem())}
+		case reflect.Struct:
+			ptr := reflect.PointerTo(t)
+			if t.Name() != "" && (g.v.autoNested || isValidatable(ptr, map[reflect.Type]bool{})) {
+				s = g.ref(ptr)
+			} else {
+				s = g.objectSchema(ptr, "", nil)
+			}
+		default:
+			s = &JSONSchema{}
+		}
+	}
+	return nullable(s, isPtr)
+}
+
+// nullable allows the null value for the schema of the pointer type.
+func nullable(s *JSONSchema, isPtr bool) *JSONSchema {
+	if typ, ok := s.Type.(string); ok && isPtr {
+		s.Type = []string{typ, "null"}
+	}
+	return s
+}
+
+// schemaType returns the JSON type of the schema, the null type is skipped.
+func schemaType(s *JSONSchema) string {
+	switch typ := s.Type.(type) {
+	case string:
+		return typ
+	case []string:
+		return typ[0]
+	}
+	return ""
+}
+
+// applyRule applies the rule of the descriptor d to the field schema s,
+// the rules without JSON Schema keywords are appended to the schema rules.
+// It returns true if the field is required.
+func applyRule(s *JSONSchema, d descriptor) bool {
+	r := d.rule
+	target := s
+	switch r.Scope {
+	case shared.RuleScopeItems:
+		target = s.Items
+	case shared.RuleScopeKeys:
+		if s.AdditionalProperties != nil && s.PropertyNames == nil {
+			s.PropertyNames = &JSONSchema{Type: "string"}
+		}
+		target = s.PropertyNames
+	case shared.RuleScopeValues:
+		target = s.AdditionalProperties
+	}
+	if target == nil || d.cond != nil || r.Conditional || !applyKeyword(target, r) {
+		s.Rules = append(s.Rules, JSONSchemaRule{
+			Name:        r.Name,
+			Params:      r.Params,
+			Scope:       r.Scope,
+			Conditional: d.cond != nil || r.Conditional,
+		})
+		return false
+	}
+	if r.Name != shared.RuleRequired || r.Scope != shared.RuleScopeValue {
+		return false
+	}
+	if typ, ok := s.Type.([]string); ok {
+		s.Type = typ[0]
+	}
+	return true
+}
+
+// isDefaultValue checks if the rule r sets the constant default value, the generated default values are not published.
+func isDefaultValue(r shared.Rule) bool {
+	return r.Name == shared.RuleDefault && len(r.Params) == 1
+}
+
+// applyKeyword sets the JSON Schema keywords of the rule r to the schema s,
+// it returns false if the rule has no keywords for the schema type.
+func applyKeyword(s *JSONSchema, r shared.Rule) bool {
+	typ := schemaType(s)
+	switch r.Name {
+	case shared.RuleRequired:
+		switch typ {
+		case "string":
+			if s.Format != "uuid" {
+				s.MinLength = maxInt(s.MinLength, 1)
+			}
+		case "array":
+			// the slice should be not nil only
+		case "object":
+			if s.AdditionalProperties != nil {
+				s.MinProperties = maxInt(s.MinProperties, 1)
+			}
+		}
+		return true
+	case shared.RuleMinLen, shared.RuleMaxLen:
+		// the length of strings is counted in bytes, but minLength and maxLength count characters,
+		// so the strings length rules are listed in the x-valigo-rules annotation
+		n, ok := intParam(r.Params)
+		if !ok || typ != "array" {
+			return false
+		}
+		if r.Name == shared.RuleMinLen {
+			s.MinItems = &n
+		} else {
+			s.MaxItems = &n
+		}
+		return true
+	case shared.RuleMinRunes, shared.RuleMaxRunes:
+		n, ok := intParam(r.Params)
+		if !ok || typ != "string" {
+			return false
+		}
+		if r.Name == shared.RuleMinRunes {
+			s.MinLength = &n
+		} else {
+			s.MaxLength = &n
+		}
+		return true
+	case shared.RuleMinEntries, shared.RuleMaxEntries:
+		n, ok := intParam(r.Params)
+		if !ok || typ != "object" {
+			return false
+		}
+		if r.Name == shared.RuleMinEntries {
+			s.MinProperties = &n
+		} else {
+			s.MaxProperties = &n
+		}
+		return true
+	case shared.RuleDefault:
+		if len(r.Params) != 1 || s.Default != nil {
+			return false
+		}
+		s.Default = r.Params[0]
+		return true
+	case shared.RuleRegexp:
+		if typ != "string" || len(r.Params) != 1 || s.Pattern != "" {
+			return false
+		}
+		s.Pattern = fmt.Sprint(r.Params[0])
+		return true
+	case shared.RuleEmail:
+		if typ != "string" || s.Format != "" {
+			return false
+		}
+		s.Format = "email"
+		return true
+	case shared.RuleAnyOf:
+		enum := make([]any, 0, len(r.Params))
+		for _, p := range r.Params {
+			if u, ok := p.(guuid.UUID); ok {
+				p = u.String()
+			}
+			enum = append(enum, p)
+		}
+		s.Enum = enum
+		return true
+	case shared.RuleMin, shared.RuleMax, shared.RuleGt, shared.RuleLt:
+		if (typ != "integer" && typ != "number") || len(r.Params) != 1 {
+			return false
+		}
+		switch r.Name {
+		case shared.RuleMin:
+			s.Minimum = r.Params[0]
+		case shared.RuleMax:
+			s.Maximum = r.Params[0]
+		case shared.RuleGt:
+			s.ExclusiveMinimum = r.Params[0]
+		case shared.RuleLt:
+			s.ExclusiveMaximum = r.Params[0]
+		}
+		return true
+	case shared.RuleAnyOfInterval:
+		if (typ != "integer" && typ != "number") || len(r.Params) != 2 {
+			return false
+		}
+		s.ExclusiveMinimum, s.ExclusiveMaximum = r.Params[0], r.Params[1]
+		return true
+	case shared.RuleUnique:
+		if typ != "array" {
+			return false
+		}
+		s.UniqueItems = true
+		return true
+	}
+	return false
+}
+
+// intParam returns the single integer parameter of the rule.
+func intParam(params []any) (int, bool) {
+	if len(params) != 1 {
+		return 0, false
+	}
+	rv := reflect.ValueOf(params[0])
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(rv.Uint()), true
+	}
+	return 0, false
+}
+
+// maxInt returns the pointer to the max of the value of the pointer v and n.
+func maxInt(v *int, n int) *int {
+	if v != nil && *v > n {
+		return v
+	}
+	return &n
+}
+
+// appendUnique appends the value to the slice if the slice does not contain it.
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,134 @@
+package valigo
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/insei/fmap/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insei/valigo/shared"
+)
+
+type testSchemaAddress struct {
+	City string
+	Zip  string
+}
+
+type testSchemaUser struct {
+	ID       uuid.UUID
+	Name     string
+	Email    *string
+	Age      int
+	Score    float64
+	Role     string
+	Tags     []string
+	Labels   map[string]int
+	Address  *testSchemaAddress
+	Billing  testSchemaAddress
+	Total    testMoney
+	Password string
+	Confirm  string
+	hidden   string
+}
+
+func TestJSONSchema(t *testing.T) {
+	v := New(WithFieldLocationNamingFn(func(field fmap.Field) string {
+		return strings.ToLower(field.GetStructPath())
+	}))
+	Configure[testSchemaAddress](v, func(c Configurator[testSchemaAddress], obj *testSchemaAddress) {
+		c.String(&obj.City).Required()
+	})
+	Configure[testSchemaUser](v, func(c Configurator[testSchemaUser], obj *testSchemaUser) {
+		c.UUID(&obj.ID).Required()
+		c.String(&obj.Name).Trim().Required().MinRunes(2).MaxRunes(50).Regexp(regexp.MustCompile(`^\w+$`))
+		c.String(&obj.Email).Email()
+		c.Number(&obj.Age).Min(18).Max(120)
+		c.Number(&obj.Score).AnyOfInterval(0.0, 1.0)
+		c.String(&obj.Role).Default("user").AnyOf("admin", "user")
+		c.StringSlice(&obj.Tags).Email().MaxLen(3)
+		c.Map(&obj.Labels).Keys().MaxRunes(10)
+		c.Map(&obj.Labels).MinEntries(1)
+		c.Struct(&obj.Address).Required()
+		c.String(&obj.Billing.Zip).MinLen(5)
+		c.String(&obj.Confirm).EqField(&obj.Password)
+		c.String(&obj.Password).When(func(ctx context.Context, value any) bool {
+			return value != nil
+		}).MinLen(8)
+		c.Custom(func(ctx context.Context, h shared.StructCustomHelper, obj *testSchemaUser) []shared.Error {
+			return nil
+		})
+	})
+
+	schema, err := v.JSONSchema(reflect.TypeOf(testSchemaUser{}))
+	assert.NoError(t, err)
+	actual, err := json.Marshal(schema)
+	assert.NoError(t, err)
+	expected := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"id": {"type": "string", "format": "uuid"},
+			"name": {"type": "string", "pattern": "^\\w+$", "minLength": 2, "maxLength": 50},
+			"email": {"type": ["string", "null"], "format": "email"},
+			"age": {"type": "integer", "minimum": 18, "maximum": 120},
+			"score": {"type": "number", "exclusiveMinimum": 0, "exclusiveMaximum": 1},
+			"role": {"type": "string", "enum": ["admin", "user"], "default": "user"},
+			"tags": {"type": "array", "maxItems": 3, "items": {"type": "string", "format": "email"}},
+			"labels": {
+				"type": "object",
+				"minProperties": 1,
+				"additionalProperties": {"type": "integer"},
+				"propertyNames": {"type": "string", "maxLength": 10}
+			},
+			"address": {"$ref": "#/$defs/testSchemaAddress"},
+			"billing": {
+				"type": "object",
+				"properties": {
+					"city": {"type": "string"},
+					"zip": {"type": "string", "x-valigo-rules": [{"name": "minLen", "params": [5]}]}
+				}
+			},
+			"total": {"$ref": "#/$defs/testMoney"},
+			"password": {
+				"type": "string",
+				"x-valigo-rules": [{"name": "minLen", "params": [8], "conditional": true}]
+			},
+			"confirm": {
+				"type": "string",
+				"x-valigo-rules": [{"name": "eqField", "params": ["Password"]}]
+			}
+		},
+		"required": ["id", "name", "address"],
+		"x-valigo-rules": [{"name": "custom"}],
+		"$defs": {
+			"testSchemaAddress": {
+				"type": "object",
+				"properties": {
+					"city": {"type": "string", "minLength": 1},
+					"zip": {"type": "string"}
+				},
+				"required": ["city"]
+			},
+			"testMoney": {
+				"type": "object",
+				"properties": {
+					"amount": {"type": "integer"},
+					"currency": {"type": "string"}
+				},
+				"x-valigo-rules": [{"name": "custom"}]
+			}
+		}
+	}`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func TestJSONSchemaNotStruct(t *testing.T) {
+	_, err := New().JSONSchema(reflect.TypeOf(""))
+	assert.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package valigo
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/insei/fmap/v3"
+
+	"github.com/insei/valigo/shared"
+	"github.com/insei/valigo/translator"
+)
+
+// Manifest is a compact description of the rules registered for the type for the client-side validation,
+// i.e. in the front-end forms.
+type Manifest struct {
+	// Type is the name of the type.
+	Type string `json:"type"`
+	// Languages are the languages of the rules messages.
+	Languages []string `json:"languages,omitempty"`
+	// Rules are the rules of the type fields and the nested struct fields in the configuration order.
+	Rules []ManifestRule `json:"rules"`
+}
+
+// ManifestRule is a rule of the Manifest.
+type ManifestRule struct {
+	// Field is the field location, see WithFieldLocationNamingFn, it is empty for the struct rules.
+	// The elements of the slices of structs are marked with "[]", i.e. "Items[].Name".
+	Field string `json:"field,omitempty"`
+	// Rule is the name of the rule, i.e. shared.RuleMaxLen.
+	Rule string `json:"rule"`
+	// Params are the parameters of the rule, the other field of the cross-field rules is the field location.
+	Params []any `json:"params,omitempty"`
+	// Scope is the part of the field value the rule is applied to.
+	Scope shared.RuleScope `json:"scope,omitempty"`
+	// Messages are the translated error messages of the rule by languages.
+	Messages map[string]string `json:"messages,omitempty"`
+	// ServerOnly is true for the rules that cannot be evaluated on the client side:
+	// the custom rules, the rules enabled with the When conditions or groups and the generated default values.
+	ServerOnly bool `json:"serverOnly,omitempty"`
+}
+
+// crossFieldRules are the names of the rules with the other field parameter.
+var crossFieldRules = map[string]struct{}{
+	shared.RuleEqField:  {},
+	shared.RuleNeField:  {},
+	shared.RuleGtField:  {},
+	shared.RuleGteField: {},
+	shared.RuleLtField:  {},
+	shared.RuleLteField: {},
+}
+
+// RulesManifest returns the manifest of the rules registered for the struct or pointer to struct type t,
+// the messages are translated to the langs with the validator translator, the translator should read
+// the languages with translator.GetPreferredLanguagesFromContext (default). The messages are omitted if langs are empty.
+// The rules of the nested struct fields validated with their own rules are included with the field location prefix.
+func (v *Validator) RulesManifest(t reflect.Type, langs ...string) (*Manifest, error) {
+	t, err := v.structType(t)
+	if err != nil {
+		return nil, err
+	}
+	m := &manifestBuilder{
+		v:       v,
+		langs:   langs,
+		visited: make(map[reflect.Type]bool),
+	}
+	m.add(reflect.PointerTo(t), "")
+	return &Manifest{
+		Type:      t.Name(),
+		Languages: langs,
+		Rules:     m.rules,
+	}, nil
+}
+
+// manifestBuilder collects the rules of the Manifest.
+type manifestBuilder struct {
+	v     *Validator
+	langs []string
+	rules []ManifestRule
+	// visited are the types on the current nesting path, it breaks the recursion of the self-referencing types.
+	visited map[reflect.Type]bool
+}
+
+// add adds the rules of the pointer to struct type t, the field locations are prefixed with the prefix.
+func (m *manifestBuilder) add(t reflect.Type, prefix string) {
+	if m.visited[t] {
+		return
+	}
+	m.visited[t] = true
+	defer delete(m.visited, t)
+	fields, err := getFields(reflect.New(t.Elem()).Interface())
+	if err != nil {
+		return
+	}
+	for _, d := range m.v.storage.getDescriptors(t) {
+		location := prefix
+		if d.field != nil {
+			location = joinLocation(prefix, m.v.helper.getFieldLocation(d.field))
+		}
+		m.addRule(fields, prefix, location, d.rule, d.cond != nil)
+	}
+	if t.Implements(validatableType) || t.Implements(validatableWithHelperType) {
+		m.addRule(fields, prefix, prefix, shared.Rule{Name: shared.RuleCustom}, false)
+	}
+	for _, path := range fields.GetAllPaths() {
+		field := fields.MustFind(path)
+		if !field.IsExported() || strings.Contains(path, ".") || !m.v.isNestedValidated(t, field) {
+			continue
+		}
+		nested, _ := getNestedStructType(field.GetType())
+		location := joinLocation(prefix, m.v.helper.getFieldLocation(field))
+		if isStructSlice(field.GetType()) {
+			location += "[]"
+		}
+		m.add(nested, location)
+	}
+}
+
+// addRule adds the rule of the field with the location, the fields are the fields of the rule owner type
+// with the locations prefix.
+func (m *manifestBuilder) addRule(fields fmap.Storage, prefix, location string, r shared.Rule, conditional bool) {
+	params := r.Params
+	if _, ok := crossFieldRules[r.Name]; ok && len(params) == 1 {
+		if path, _ := params[0].(string); path != "" {
+			if other, ok := fields.Find(path); ok {
+				params = []any{joinLocation(prefix, m.v.helper.getFieldLocation(other))}
+			}
+		}
+	}
+	// the generated default values are unknown on the client side
+	generated := r.Name == shared.RuleDefault && len(params) == 0
+	rule := ManifestRule{
+		Field:      location,
+		Rule:       r.Name,
+		Params:     params,
+		Scope:      r.Scope,
+		ServerOnly: r.Name == shared.RuleCustom || conditional || r.Conditional || generated,
+	}
+	if r.LocaleKey != "" && len(m.langs) > 0 {
+		rule.Messages = make(map[string]string, len(m.langs))
+		for _, lang := range m.langs {
+			ctx := translator.WithPreferredLanguages(context.Background(), lang)
+			rule.Messages[lang] = m.v.helper.t.T(ctx, r.LocaleKey, r.LocaleArgs...)
+		}
+	}
+	m.rules = append(m.rules, rule)
+}
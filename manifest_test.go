@@ -0,0 +1,103 @@
+package valigo
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/insei/fmap/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insei/valigo/shared"
+)
+
+type testManifestLine struct {
+	Name string `json:"name"`
+}
+
+type testManifestForm struct {
+	Name     string             `json:"name"`
+	Password string             `json:"password"`
+	Confirm  string             `json:"confirm"`
+	Age      int                `json:"age"`
+	Lines    []testManifestLine `json:"lines"`
+	Total    testMoney          `json:"total"`
+}
+
+func TestRulesManifest(t *testing.T) {
+	v := New(WithFieldLocationNamingFn(func(field fmap.Field) string {
+		return field.GetTagPath("json", false)
+	}))
+	Configure[testManifestLine](v, func(c Configurator[testManifestLine], obj *testManifestLine) {
+		c.String(&obj.Name).Required()
+	})
+	Configure[testManifestForm](v, func(c Configurator[testManifestForm], obj *testManifestForm) {
+		c.String(&obj.Name).MaxLen(10)
+		c.String(&obj.Confirm).EqField(&obj.Password)
+		c.Number(&obj.Age).When(func(ctx context.Context, value any) bool {
+			return true
+		}).Min(18)
+		c.StructSlice(&obj.Lines).MaxLen(5)
+		c.Custom(func(ctx context.Context, h shared.StructCustomHelper, obj *testManifestForm) []shared.Error {
+			return nil
+		})
+	})
+
+	manifest, err := v.RulesManifest(reflect.TypeOf(&testManifestForm{}), "en", "ru")
+	assert.NoError(t, err)
+	actual, err := json.Marshal(manifest)
+	assert.NoError(t, err)
+	expected := `{
+		"type": "testManifestForm",
+		"languages": ["en", "ru"],
+		"rules": [
+			{
+				"field": "name",
+				"rule": "maxLen",
+				"params": [10],
+				"messages": {"en": "Cannot be longer than 10 characters", "ru": "Не может быть длиннее 10 символов"}
+			},
+			{
+				"field": "confirm",
+				"rule": "eqField",
+				"params": ["password"],
+				"messages": {"en": "Should be equal to password", "ru": "Должно быть равно password"}
+			},
+			{
+				"field": "age",
+				"rule": "min",
+				"params": [18],
+				"messages": {"en": "Cannot be less than 18", "ru": "Не может быть меньше 18"},
+				"serverOnly": true
+			},
+			{
+				"field": "lines",
+				"rule": "maxLen",
+				"params": [5],
+				"messages": {"en": "max len error", "ru": "max len error"}
+			},
+			{"rule": "custom", "serverOnly": true},
+			{
+				"field": "lines[].name",
+				"rule": "required",
+				"messages": {"en": "Should be fulfilled", "ru": "Должно быть заполнено"}
+			},
+			{"field": "total", "rule": "custom", "serverOnly": true}
+		]
+	}`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func TestRulesManifestWithoutLanguages(t *testing.T) {
+	v := New()
+	Configure[testManifestLine](v, func(c Configurator[testManifestLine], obj *testManifestLine) {
+		c.String(&obj.Name).Required()
+	})
+	manifest, err := v.RulesManifest(reflect.TypeOf(testManifestLine{}))
+	assert.NoError(t, err)
+	assert.Equal(t, []ManifestRule{{Field: "Name", Rule: shared.RuleRequired}}, manifest.Rules)
+
+	_, err = v.RulesManifest(reflect.TypeOf(1))
+	assert.Error(t, err)
+}
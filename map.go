@@ -0,0 +1,288 @@
+package valigo
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/insei/fmap/v3"
+
+	"github.com/insei/valigo/num"
+	"github.com/insei/valigo/shared"
+	"github.com/insei/valigo/str"
+)
+
+// MapFieldConfigurator is a configurator for map fields.
+// Besides the map entries count rules, it allows to add rules applied to each map key and value,
+// error locations of the key and value rules are formatted as Field[key].
+// The key and value rules are the part of the rules chain, so the When condition and Bail apply to them.
+type MapFieldConfigurator struct {
+	*shared.MapFieldConfigurator
+	field   fmap.Field
+	v       *Validator
+	mapType reflect.Type
+	errFn   shared.ConfigErrorFn
+	// setNested marks the field as the explicitly configured nested field, nil for the invalid field.
+	setNested func()
+	entries   *mapEntries
+}
+
+// mapEntries is the rules of the map entries of the rules chain, the entries validation function
+// is appended to the chain with the first entries rule.
+type mapEntries struct {
+	once         sync.Once
+	appendFn     func(fn shared.FieldValidationFn)
+	describeFn   shared.DescribeFn
+	keyFns       shared.FieldValidationFns
+	valueFns     shared.FieldValidationFns
+	structValues atomic.Bool
+}
+
+// withChain returns the configurator of the same map field with the rules chain c.
+func (m *MapFieldConfigurator) withChain(c *shared.MapFieldConfigurator) *MapFieldConfigurator {
+	appendFn, describeFn := c.EntriesRules()
+	return &MapFieldConfigurator{
+		MapFieldConfigurator: c,
+		field:                m.field,
+		v:                    m.v,
+		mapType:              m.mapType,
+		errFn:                m.errFn,
+		setNested:            m.setNested,
+		entries:              &mapEntries{appendFn: appendFn, describeFn: describeFn},
+	}
+}
+
+// getEntries returns the entries rules of the chain and appends the entries validation function to the chain once.
+func (m *MapFieldConfigurator) getEntries() *mapEntries {
+	e := m.entries
+	e.once.Do(func() {
+		e.appendFn(func(ctx context.Context, h shared.Helper, value any) []shared.Error {
+			return m.validateEntries(ctx, h, value, e)
+		})
+	})
+	return e
+}
+
+// When allows for conditional validation based on a given condition,
+// the condition applies to the following map, key and value rules.
+func (m *MapFieldConfigurator) When(whenFn func(ctx context.Context, value any) bool) *MapFieldConfigurator {
+	if whenFn == nil {
+		return m
+	}
+	return m.withChain(m.MapFieldConfigurator.When(whenFn))
+}
+
+// Bail stops the evaluation of the following rules at the first failed rule,
+// the key and value rules of the chain are evaluated as a single rule.
+func (m *MapFieldConfigurator) Bail() *MapFieldConfigurator {
+	return m.withChain(m.MapFieldConfigurator.Bail())
+}
+
+// MinEntries checks if the map contains at least minEntries entries.
+func (m *MapFieldConfigurator) MinEntries(minEntries int) *MapFieldConfigurator {
+	m.MapFieldConfigurator.MinEntries(minEntries)
+	return m
+}
+
+// MaxEntries checks if the map contains no more than maxEntries entries.
+func (m *MapFieldConfigurator) MaxEntries(maxEntries int) *MapFieldConfigurator {
+	m.MapFieldConfigurator.MaxEntries(maxEntries)
+	return m
+}
+
+// Required checks if the map is not empty.
+func (m *MapFieldConfigurator) Required() *MapFieldConfigurator {
+	m.MapFieldConfigurator.Required()
+	return m
+}
+
+// Custom allows for custom validation logic, the value is the map itself.
+func (m *MapFieldConfigurator) Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error, opts ...shared.CustomOption) *MapFieldConfigurator {
+	m.MapFieldConfigurator.Custom(f, opts...)
+	return m
+}
+
+// Keys returns str.BaseConfigurator for rules applied to each map key, map key should be a string.
+func (m *MapFieldConfigurator) Keys() str.BaseConfigurator {
+	e := m.getEntries()
+	return str.NewElementConfigurator(str.ElementConfiguratorParams{
+		Type:       m.mapType.Key(),
+		Field:      m.field,
+		Helper:     m.v.GetHelper(),
+		AppendFn:   e.keyFns.Append,
+		ErrorFn:    m.errFn,
+		DescribeFn: e.describeFn.WithScope(shared.RuleScopeKeys),
+	})
+}
+
+// StringValues returns str.BaseConfigurator for rules applied to each map value,
+// map value should be a string or a pointer to string.
+func (m *MapFieldConfigurator) StringValues() str.BaseConfigurator {
+	e := m.getEntries()
+	return str.NewElementConfigurator(str.ElementConfiguratorParams{
+		Type:       m.mapType.Elem(),
+		Field:      m.field,
+		Helper:     m.v.GetHelper(),
+		AppendFn:   e.valueFns.Append,
+		ErrorFn:    m.errFn,
+		DescribeFn: e.describeFn.WithScope(shared.RuleScopeValues),
+	})
+}
+
+// NumberValues returns num.BaseConfigurator for rules applied to each map value,
+// map value should be a number or a pointer to number.
+func (m *MapFieldConfigurator) NumberValues() num.BaseConfigurator {
+	e := m.getEntries()
+	return num.NewElementConfigurator(num.ElementConfiguratorParams{
+		Type:       m.mapType.Elem(),
+		Field:      m.field,
+		Helper:     m.v.GetHelper(),
+		AppendFn:   e.valueFns.Append,
+		ErrorFn:    m.errFn,
+		DescribeFn: e.describeFn.WithScope(shared.RuleScopeValues),
+	})
+}
+
+// StructValues validates each map value with the rules registered for the value type,
+// map value should be a struct or a pointer to struct.
+func (m *MapFieldConfigurator) StructValues() *MapFieldConfigurator {
+	elemType := m.mapType.Elem()
+	for elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		m.errFn.Report(shared.NewConfigError(m.field, "map value type %s is not a struct or pointer to struct", m.mapType.Elem().String()))
+		return m
+	}
+	if m.setNested != nil {
+		m.setNested()
+	}
+	m.getEntries().structValues.Store(true)
+	return m
+}
+
+// validateEntries runs the key and value rules of the entries e for each map entry in the keys order.
+func (m *MapFieldConfigurator) validateEntries(ctx context.Context, h shared.Helper, value any, e *mapEntries) []shared.Error {
+	keyFns, valueFns, structValues := e.keyFns.Load(), e.valueFns.Load(), e.structValues.Load()
+	if len(keyFns) == 0 && len(valueFns) == 0 && !structValues {
+		return nil
+	}
+	rv, ok := derefMap(value)
+	if !ok || rv.Len() == 0 {
+		return nil
+	}
+	keys := sortedMapKeys(rv)
+	prefix := m.v.helper.getFieldLocation(m.field)
+	// entries errors are relocated, so results of the async rules are needed immediately
+	ctx = shared.WithoutAsyncRunner(ctx)
+	var errs []shared.Error
+	for _, key := range keys {
+		if ctx.Err() != nil {
+			break
+		}
+		location := prefix + "[" + fmt.Sprint(key.Interface()) + "]"
+		if len(keyFns) > 0 {
+			keyPtr := reflect.New(key.Type())
+			keyPtr.Elem().Set(key)
+			for _, fn := range keyFns {
+				errs = append(errs, relocate(fn(ctx, h, keyPtr.Interface()), location)...)
+			}
+		}
+		if len(valueFns) == 0 && !structValues {
+			continue
+		}
+		val := rv.MapIndex(key)
+		valPtr := reflect.New(val.Type())
+		valPtr.Elem().Set(val)
+		for _, fn := range valueFns {
+			errs = append(errs, relocate(fn(ctx, h, valPtr.Interface()), location)...)
+		}
+		if structValues {
+			if obj, ok := derefStruct(valPtr.Interface()); ok {
+				errs = append(errs, m.v.validateNested(ctx, obj, location)...)
+			}
+		}
+	}
+	return errs
+}
+
+// sortedMapKeys returns the keys of the map value rv in the order of the errors locations:
+// the numeric keys are sorted by value, the other keys by their string representation.
+func sortedMapKeys(rv reflect.Value) []reflect.Value {
+	keys := rv.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return lessMapKey(keys[i], keys[j])
+	})
+	return keys
+}
+
+// lessMapKey reports whether the map key a is ordered before the key b of the same type.
+func lessMapKey(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	}
+	return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+}
+
+// derefMap dereferences a pointer to the map field value (*map, **map) to the map reflect.Value.
+func derefMap(value any) (reflect.Value, bool) {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return reflect.Value{}, false
+		}
+		rv = rv.Elem()
+	}
+	return rv, rv.Kind() == reflect.Map
+}
+
+// relocate sets the location for all errors.
+func relocate(errs []shared.Error, location string) []shared.Error {
+	for i := range errs {
+		errs[i].Location = location
+	}
+	return errs
+}
+
+// Map returns MapFieldConfigurator for map field validation.
+func (b *builder[T]) Map(mapFieldPtr any) *MapFieldConfigurator {
+	r := b.fieldRules(mapFieldPtr, "map field")
+	// the placeholder type of the invalid field, its rules are discarded
+	mapType := reflect.TypeOf(map[string]any(nil))
+	var setNested func()
+	switch {
+	case r.field == nil:
+	case r.field.GetDereferencedType().Kind() != reflect.Map:
+		r.errFn.Report(shared.NewConfigError(r.field, "type %s is not a map", r.field.GetType().String()))
+		r = r.discard()
+	default:
+		mapType = r.field.GetDereferencedType()
+		setNested = func() {
+			b.batch.setExplicitNested(r.field)
+		}
+	}
+	m := &MapFieldConfigurator{
+		field:     r.field,
+		v:         b.v,
+		mapType:   mapType,
+		errFn:     r.errFn,
+		setNested: setNested,
+	}
+	return m.withChain(shared.NewMapFieldConfigurator(shared.MapFieldConfiguratorParams{
+		Field:      r.field,
+		Helper:     b.v.GetHelper(),
+		AppendFn:   r.appendFn,
+		ErrorFn:    r.errFn,
+		DescribeFn: r.describeFn,
+	}))
+}
@@ -0,0 +1,131 @@
+package valigo
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type metadata struct {
+	Labels    map[string]string
+	Limits    map[string]int
+	Addresses map[string]*address
+	PtrLabels *map[string]*string
+}
+
+func TestBuilderMap(t *testing.T) {
+	v := New()
+	Configure[address](v, func(c Configurator[address], obj *address) {
+		c.String(&obj.City).Required()
+	})
+	Configure[metadata](v, func(c Configurator[metadata], obj *metadata) {
+		labels := c.Map(&obj.Labels)
+		labels.MinEntries(1).MaxEntries(3)
+		labels.Keys().Regexp(regexp.MustCompile(`^[a-z]+$`))
+		labels.StringValues().Trim().MaxLen(5)
+		c.Map(&obj.Limits).NumberValues().Max(10)
+		c.Map(&obj.Addresses).StructValues()
+		c.Map(&obj.PtrLabels).StringValues().Required()
+	})
+	empty := ""
+
+	testCases := []struct {
+		name      string
+		obj       *metadata
+		locations []string
+	}{
+		{
+			name: "valid",
+			obj: &metadata{
+				Labels:    map[string]string{"env": " prod "},
+				Limits:    map[string]int{"cpu": 2},
+				Addresses: map[string]*address{"home": {City: "Paris"}, "work": nil},
+			},
+		},
+		{
+			name: "invalid entries",
+			obj: &metadata{
+				Labels:    map[string]string{"env": "production", "Team": "a", "app": "b"},
+				Limits:    map[string]int{"cpu": 2, "mem": 20},
+				Addresses: map[string]*address{"home": {}},
+				PtrLabels: &map[string]*string{"env": &empty},
+			},
+			locations: []string{"Labels[Team]", "Labels[env]", "Limits[mem]", "Addresses[home].City", "PtrLabels[env]"},
+		},
+		{
+			name:      "entries count",
+			obj:       &metadata{},
+			locations: []string{"Labels"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := v.ValidateTyped(context.Background(), tc.obj)
+			var locations []string
+			for _, err := range errs {
+				locations = append(locations, err.Location)
+			}
+			assert.Equal(t, tc.locations, locations)
+		})
+	}
+}
+
+func TestBuilderMapTrimValues(t *testing.T) {
+	v := New()
+	Configure[metadata](v, func(c Configurator[metadata], obj *metadata) {
+		c.Map(&obj.Labels).StringValues().Trim()
+	})
+	obj := &metadata{Labels: map[string]string{"env": " prod "}}
+	errs := v.ValidateTyped(context.Background(), obj)
+	assert.Empty(t, errs)
+	assert.Equal(t, "prod", obj.Labels["env"])
+}
+
+func TestBuilderMapPanic(t *testing.T) {
+	v := New()
+	assert.Panics(t, func() {
+		Configure[metadata](v, func(c Configurator[metadata], obj *metadata) {
+			c.Map(&obj.Limits).StringValues()
+		})
+	})
+	assert.Panics(t, func() {
+		Configure[metadata](v, func(c Configurator[metadata], obj *metadata) {
+			c.Map(&obj.Labels).StructValues()
+		})
+	})
+}
+
+type inventory struct {
+	Tags  map[string]string
+	Items map[int]string
+}
+
+func TestBuilderMapChain(t *testing.T) {
+	v := New()
+	Configure[inventory](v, func(c Configurator[inventory], obj *inventory) {
+		c.Map(&obj.Tags).When(func(ctx context.Context, value any) bool {
+			return len(*value.(*map[string]string)) > 1
+		}).MaxEntries(3).Keys().MaxLen(3)
+		c.Map(&obj.Items).Bail().MinEntries(2).StringValues().Required()
+	})
+
+	errs := v.ValidateTyped(context.Background(), &inventory{
+		Tags:  map[string]string{"long": ""},
+		Items: map[int]string{1: ""},
+	})
+	// the keys rules are conditional, the values rules are skipped after the failed entries count
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Items", errs[0].Location)
+
+	errs = v.ValidateTyped(context.Background(), &inventory{
+		Tags:  map[string]string{"long": "", "a": ""},
+		Items: map[int]string{10: "", 2: "", 1: "a"},
+	})
+	var locations []string
+	for _, err := range errs {
+		locations = append(locations, err.Location)
+	}
+	assert.Equal(t, []string{"Tags[long]", "Items[2]", "Items[10]"}, locations)
+}
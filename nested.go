@@ -0,0 +1,279 @@
+package valigo
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/insei/fmap/v3"
+
+	"github.com/insei/valigo/shared"
+)
+
+const (
+	structRequiredLocaleKey = "validation:struct:Should be fulfilled"
+)
+
+// ErrStructRequired is the sentinel error of the nested struct Required rule.
+var ErrStructRequired = shared.NewCodeError(shared.KindStruct, shared.RuleRequired)
+
+// StructSliceFieldConfigurator is a configurator for slice of structs fields.
+// Each element is validated with the rules registered for the element type,
+// error locations are prefixed with the location of the parent field and element index.
+type StructSliceFieldConfigurator struct {
+	*shared.SliceFieldConfigurator
+}
+
+// StructFieldConfigurator is a configurator for nested struct fields.
+// The nested struct is validated with the rules registered for its own type,
+// error locations are prefixed with the location of the parent field.
+type StructFieldConfigurator struct {
+	field      fmap.Field
+	appendFn   func(fn shared.FieldValidationFn)
+	describeFn shared.DescribeFn
+}
+
+// Required checks if the pointer to the nested struct is not nil.
+func (s *StructFieldConfigurator) Required() *StructFieldConfigurator {
+	s.describeFn.Describe(shared.Rule{Name: shared.RuleRequired, LocaleKey: structRequiredLocaleKey})
+	s.appendFn(func(ctx context.Context, h shared.Helper, v any) []shared.Error {
+		if _, ok := derefStruct(v); !ok {
+			err := h.ErrorT(ctx, s.field, nil, structRequiredLocaleKey)
+			return []shared.Error{err.WithCode(string(ErrStructRequired))}
+		}
+		return nil
+	})
+	return s
+}
+
+// derefStruct dereferences a pointer to a struct field value (*T, **T)
+// down to the pointer to the struct (*T). It returns false for nil pointers.
+func derefStruct(value any) (any, bool) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return nil, false
+	}
+	for rv.Elem().Kind() == reflect.Ptr {
+		rv = rv.Elem()
+		if rv.IsNil() {
+			return nil, false
+		}
+	}
+	if rv.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	return rv.Interface(), true
+}
+
+// isStructSlice checks if the type is a slice (or pointer to slice) of structs or pointers to structs.
+func isStructSlice(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Slice {
+		return false
+	}
+	t = t.Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+// isStructMap checks if the type is a map (or pointer to map) of structs or pointers to structs.
+func isStructMap(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Map {
+		return false
+	}
+	t = t.Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+// joinLocation joins the parent location with the nested one.
+func joinLocation(prefix, location string) string {
+	switch {
+	case prefix == "":
+		return location
+	case location == "":
+		return prefix
+	case strings.HasPrefix(location, "["):
+		return prefix + location
+	default:
+		return prefix + "." + location
+	}
+}
+
+// validateNested validates the nested struct obj using the rules registered for its type
+// and prefixes the error locations with the prefix.
+func (v *Validator) validateNested(ctx context.Context, obj any, prefix string) []shared.Error {
+	errs := v.validate(ctx, obj)
+	for i := range errs {
+		errs[i].Location = joinLocation(prefix, errs[i].Location)
+	}
+	return errs
+}
+
+// newNestedFn returns a field validation function that validates the nested struct field value
+// with the rules registered for the field type.
+func (v *Validator) newNestedFn(field fmap.Field) shared.FieldValidationFn {
+	return func(ctx context.Context, h shared.Helper, value any) []shared.Error {
+		obj, ok := derefStruct(value)
+		if !ok {
+			return nil
+		}
+		return v.validateNested(ctx, obj, v.helper.getFieldLocation(field))
+	}
+}
+
+// validateNestedSlice validates each element of the slice of structs with the rules
+// registered for the element type, error locations are prefixed with the prefix and element index.
+func (v *Validator) validateNestedSlice(ctx context.Context, value any, prefix string) []shared.Error {
+	return forEachNested(ctx, value, prefix, v.validateNested)
+}
+
+// forEachNested calls the validateFn for each not nil element of the slice of structs with
+// the pointer to the element and the prefix with element index.
+func forEachNested(ctx context.Context, value any, prefix string, validateFn func(ctx context.Context, obj any, prefix string) []shared.Error) []shared.Error {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	var errs []shared.Error
+	for i := 0; i < rv.Len() && ctx.Err() == nil; i++ {
+		elem := rv.Index(i)
+		if elem.Kind() != reflect.Ptr {
+			elem = elem.Addr()
+		}
+		obj, ok := derefStruct(elem.Interface())
+		if !ok {
+			continue
+		}
+		errs = append(errs, validateFn(ctx, obj, prefix+"["+strconv.Itoa(i)+"]")...)
+	}
+	return errs
+}
+
+// newNestedSliceFn returns a field validation function that validates each element
+// of the slice of structs field value with the rules registered for the element type.
+func (v *Validator) newNestedSliceFn(field fmap.Field) shared.FieldValidationFn {
+	return func(ctx context.Context, h shared.Helper, value any) []shared.Error {
+		return v.validateNestedSlice(ctx, value, v.helper.getFieldLocation(field))
+	}
+}
+
+// validateNestedMap validates each value of the map of structs with the rules registered for the value type
+// in the keys order, error locations are prefixed with the prefix and the key.
+func (v *Validator) validateNestedMap(ctx context.Context, value any, prefix string) []shared.Error {
+	rv, ok := derefMap(value)
+	if !ok {
+		return nil
+	}
+	var errs []shared.Error
+	for _, key := range sortedMapKeys(rv) {
+		if ctx.Err() != nil {
+			break
+		}
+		// the map values aren't addressable, the copy of the value is validated
+		valPtr := reflect.New(rv.Type().Elem())
+		valPtr.Elem().Set(rv.MapIndex(key))
+		if obj, ok := derefStruct(valPtr.Interface()); ok {
+			errs = append(errs, v.validateNested(ctx, obj, prefix+"["+fmt.Sprint(key.Interface())+"]")...)
+		}
+	}
+	return errs
+}
+
+// validateAutoNested validates all nested struct, slice of structs and map of structs fields of the obj,
+// that was not configured explicitly with the Configurator.Struct, Configurator.StructSlice
+// or MapFieldConfigurator.StructValues.
+func (v *Validator) validateAutoNested(ctx context.Context, obj any) []shared.Error {
+	var errs []shared.Error
+	sel := selectionFromContext(ctx)
+	for _, field := range v.storage.getAutoNestedFields(obj) {
+		ctx := ctx
+		if sel != nil {
+			ok, nested := sel.match(field.GetStructPath())
+			if !ok {
+				continue
+			}
+			ctx = withSelection(ctx, nested)
+		}
+		location := v.helper.getFieldLocation(field)
+		switch {
+		case isStructSlice(field.GetType()):
+			errs = append(errs, v.validateNestedSlice(ctx, field.GetPtr(obj), location)...)
+		case isStructMap(field.GetType()):
+			errs = append(errs, v.validateNestedMap(ctx, field.GetPtr(obj), location)...)
+		default:
+			if nested, ok := derefStruct(field.GetPtr(obj)); ok {
+				errs = append(errs, v.validateNested(ctx, nested, location)...)
+			}
+		}
+	}
+	return errs
+}
+
+// Struct validates the nested struct field with the rules registered for the field type.
+func (b *builder[T]) Struct(structFieldPtr any) *StructFieldConfigurator {
+	r := b.fieldRules(structFieldPtr, "struct field")
+	switch {
+	case r.field == nil:
+	case r.field.GetDereferencedType().Kind() != reflect.Struct:
+		r.errFn.Report(shared.NewConfigError(r.field, "type %s is not a struct or pointer to struct", r.field.GetType().String()))
+		r = r.discard()
+	default:
+		b.batch.setExplicitNested(r.field)
+		r.appendFn(b.v.newNestedFn(r.field))
+	}
+	return &StructFieldConfigurator{
+		field:      r.field,
+		appendFn:   r.appendFn,
+		describeFn: r.describeFn,
+	}
+}
+
+// StructSlice validates each element of the slice of structs field with the rules registered for the element type.
+func (b *builder[T]) StructSlice(sliceFieldPtr any) *StructSliceFieldConfigurator {
+	r := b.fieldRules(sliceFieldPtr, "slice field")
+	switch {
+	case r.field == nil:
+	case !isStructSlice(r.field.GetType()):
+		r.errFn.Report(shared.NewConfigError(r.field, "type %s is not a slice of structs", r.field.GetType().String()))
+		r = r.discard()
+	default:
+		b.batch.setExplicitNested(r.field)
+		r.appendFn(b.v.newNestedSliceFn(r.field))
+	}
+	return &StructSliceFieldConfigurator{
+		shared.NewSliceFieldConfigurator(r.sliceParams(b.v.GetHelper())),
+	}
+}
+
+// isNestedValidated checks if the nested struct, slice of structs or map of structs field of the owner type
+// (pointer to struct) is validated with the rules registered for the struct type.
+func (v *Validator) isNestedValidated(owner reflect.Type, field fmap.Field) bool {
+	t, ok := getNestedStructType(field.GetType())
+	if !ok {
+		return false
+	}
+	if v.storage.isExplicitNested(owner, field.GetStructPath()) {
+		return true
+	}
+	isStruct := field.GetDereferencedType().Kind() == reflect.Struct || isStructSlice(field.GetType())
+	if v.autoNested {
+		return isStruct || isStructMap(field.GetType())
+	}
+	// the self-validating values of the maps aren't validated
+	return isStruct && isValidatable(t, map[reflect.Type]bool{})
+}
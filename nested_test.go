@@ -0,0 +1,199 @@
+package valigo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/insei/fmap/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	City   string
+	Street *string
+}
+
+type order struct {
+	Name        string
+	Address     address
+	PtrAddress  *address
+	AutoAddress address
+}
+
+func TestBuilderStruct(t *testing.T) {
+	v := New()
+	Configure[address](v, func(c Configurator[address], obj *address) {
+		c.String(&obj.City).Required()
+		c.String(&obj.Street).Required()
+	})
+	Configure[order](v, func(c Configurator[order], obj *order) {
+		c.String(&obj.Name).Required()
+		c.Struct(&obj.Address)
+		c.Struct(&obj.PtrAddress).Required()
+	})
+	street := "Main"
+
+	testCases := []struct {
+		name      string
+		order     *order
+		locations []string
+	}{
+		{
+			name: "valid",
+			order: &order{
+				Name:       "order",
+				Address:    address{City: "Paris", Street: &street},
+				PtrAddress: &address{City: "Rome", Street: &street},
+			},
+		},
+		{
+			name: "invalid nested",
+			order: &order{
+				Name:       "order",
+				Address:    address{Street: &street},
+				PtrAddress: &address{City: "Rome", Street: new(string)},
+			},
+			locations: []string{"Address.City", "PtrAddress.Street"},
+		},
+		{
+			name: "nil required nested",
+			order: &order{
+				Name:    "order",
+				Address: address{City: "Paris", Street: &street},
+			},
+			locations: []string{"PtrAddress"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := v.ValidateTyped(context.Background(), tc.order)
+			var locations []string
+			for _, err := range errs {
+				locations = append(locations, err.Location)
+			}
+			assert.Equal(t, tc.locations, locations)
+		})
+	}
+}
+
+func TestBuilderStructWithFieldLocationNamingFn(t *testing.T) {
+	v := New(WithFieldLocationNamingFn(func(field fmap.Field) string {
+		return "$" + field.GetName()
+	}))
+	Configure[address](v, func(c Configurator[address], obj *address) {
+		c.String(&obj.City).Required()
+	})
+	Configure[order](v, func(c Configurator[order], obj *order) {
+		c.Struct(&obj.PtrAddress)
+	})
+	errs := v.ValidateTyped(context.Background(), &order{PtrAddress: &address{}})
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "$PtrAddress.$City", errs[0].Location)
+}
+
+func TestBuilderStructPanic(t *testing.T) {
+	v := New()
+	assert.Panics(t, func() {
+		Configure[order](v, func(c Configurator[order], obj *order) {
+			c.Struct(&obj.Name)
+		})
+	})
+}
+
+func TestWithAutoNestedValidation(t *testing.T) {
+	v := New(WithAutoNestedValidation())
+	Configure[address](v, func(c Configurator[address], obj *address) {
+		c.String(&obj.City).Required()
+	})
+	Configure[order](v, func(c Configurator[order], obj *order) {
+		c.Struct(&obj.Address)
+	})
+	errs := v.ValidateTyped(context.Background(), &order{PtrAddress: &address{}})
+	var locations []string
+	for _, err := range errs {
+		locations = append(locations, err.Location)
+	}
+	assert.Equal(t, []string{"Address.City", "PtrAddress.City", "AutoAddress.City"}, locations)
+}
+
+func TestJoinLocation(t *testing.T) {
+	assert.Equal(t, "A.B", joinLocation("A", "B"))
+	assert.Equal(t, "A[0]", joinLocation("A", "[0]"))
+	assert.Equal(t, "A", joinLocation("A", ""))
+	assert.Equal(t, "B", joinLocation("", "B"))
+}
+
+type item struct {
+	Sku string
+}
+
+type cart struct {
+	Items    []item
+	PtrItems []*item
+	Auto     *[]item
+}
+
+func TestBuilderStructSlice(t *testing.T) {
+	v := New()
+	Configure[item](v, func(c Configurator[item], obj *item) {
+		c.String(&obj.Sku).Required()
+	})
+	Configure[cart](v, func(c Configurator[cart], obj *cart) {
+		c.StructSlice(&obj.Items).MinLen(1)
+		c.StructSlice(&obj.PtrItems)
+	})
+
+	testCases := []struct {
+		name      string
+		cart      *cart
+		locations []string
+	}{
+		{
+			name: "valid",
+			cart: &cart{
+				Items:    []item{{Sku: "1"}},
+				PtrItems: []*item{{Sku: "2"}, nil},
+			},
+		},
+		{
+			name: "invalid elements",
+			cart: &cart{
+				Items:    []item{{Sku: "1"}, {}, {Sku: "3"}, {}},
+				PtrItems: []*item{nil, {}},
+			},
+			locations: []string{"Items[1].Sku", "Items[3].Sku", "PtrItems[1].Sku"},
+		},
+		{
+			name:      "empty slice",
+			cart:      &cart{},
+			locations: []string{"Items"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := v.ValidateTyped(context.Background(), tc.cart)
+			var locations []string
+			for _, err := range errs {
+				locations = append(locations, err.Location)
+			}
+			assert.Equal(t, tc.locations, locations)
+		})
+	}
+}
+
+func TestWithAutoNestedValidationStructSlice(t *testing.T) {
+	v := New(WithAutoNestedValidation())
+	Configure[item](v, func(c Configurator[item], obj *item) {
+		c.String(&obj.Sku).Required()
+	})
+	errs := v.ValidateTyped(context.Background(), &cart{
+		Items:    []item{{}},
+		PtrItems: []*item{{Sku: "1"}, {}},
+		Auto:     &[]item{{}},
+	})
+	var locations []string
+	for _, err := range errs {
+		locations = append(locations, err.Location)
+	}
+	assert.Equal(t, []string{"Items[0].Sku", "PtrItems[1].Sku", "Auto[0].Sku"}, locations)
+}
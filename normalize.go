@@ -0,0 +1,249 @@
+package valigo
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/insei/valigo/shared"
+)
+
+// newNormalizationFn returns the validation function of the normalization plan applying the normalization fn
+// to the pointer to the field value, slice elements, map keys or map values depending on the scope.
+func newNormalizationFn(scope shared.RuleScope, fn shared.FieldNormalizationFn) shared.FieldValidationFn {
+	return func(ctx context.Context, _ shared.Helper, value any) []shared.Error {
+		normalizeScope(ctx, scope, fn, value)
+		return nil
+	}
+}
+
+// normalizeScope applies the normalization fn to the pointer to the field value,
+// slice elements, map keys or map values depending on the scope.
+func normalizeScope(ctx context.Context, scope shared.RuleScope, fn shared.FieldNormalizationFn, value any) {
+	if scope == shared.RuleScopeValue {
+		fn(ctx, value)
+		return
+	}
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return
+		}
+		rv = rv.Elem()
+	}
+	switch {
+	case scope == shared.RuleScopeItems && rv.Kind() == reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			fn(ctx, rv.Index(i).Addr().Interface())
+		}
+	case scope == shared.RuleScopeKeys && rv.Kind() == reflect.Map:
+		for _, key := range rv.MapKeys() {
+			keyPtr := reflect.New(key.Type())
+			keyPtr.Elem().Set(key)
+			fn(ctx, keyPtr.Interface())
+			if !keyPtr.Elem().Equal(key) {
+				// the entry of the normalized key replaces the existing one
+				val := rv.MapIndex(key)
+				rv.SetMapIndex(key, reflect.Value{})
+				rv.SetMapIndex(keyPtr.Elem(), val)
+			}
+		}
+	case scope == shared.RuleScopeValues && rv.Kind() == reflect.Map:
+		iter := rv.MapRange()
+		for iter.Next() {
+			valPtr := reflect.New(iter.Value().Type())
+			valPtr.Elem().Set(iter.Value())
+			fn(ctx, valPtr.Interface())
+			rv.SetMapIndex(iter.Key(), valPtr.Elem())
+		}
+	}
+}
+
+// dryRunContextKey is the context key of the dry-run validation flag.
+type dryRunContextKey struct{}
+
+// ContextWithDryRun returns the copy of the ctx for the dry-run validation: the validated object is never modified,
+// the deep copy of the object is normalized and validated instead, i.e. for the read-only callers
+// validating the stored records. Only the exported fields are copied deeply.
+func ContextWithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunContextKey{}, true)
+}
+
+// isDryRun checks if the ctx is the dry-run validation context.
+func isDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunContextKey{}).(bool)
+	return dryRun
+}
+
+// Normalize applies the normalization rules (i.e. Trim) registered for the type of the obj
+// and the types of its nested struct fields in the declaration order.
+// The validation normalizes the object before the validation rules, so the order of the normalization rules
+// relative to the validation rules doesn't depend on the rules chain order.
+// It returns the context error if the ctx is done and the tags configuration error.
+func (v *Validator) Normalize(ctx context.Context, obj any) error {
+	if v.tagDialect != nil && v.storage.getPlan(reflect.TypeOf(obj)) == nil {
+		if err := v.configureLazyFromTags(obj); err != nil {
+			return err
+		}
+	}
+	v.normalize(ctx, obj)
+	return ctx.Err()
+}
+
+// normalize applies the normalization rules registered for the type of the obj and the types of its
+// nested struct fields validated as nested structs, the fields are filtered with the selection of the partial validation.
+func (v *Validator) normalize(ctx context.Context, obj any) {
+	t := reflect.TypeOf(obj)
+	if t == nil {
+		return
+	}
+	sel := selectionFromContext(ctx)
+	if p := v.storage.getNormalizationPlan(t); p != nil {
+		for _, b := range p.blocks {
+			if len(b.groups) > 0 && !groupsFromContext(ctx).containsAll(b.groups) {
+				continue
+			}
+			if b.enabler != nil && !b.enabler(ctx, obj) {
+				continue
+			}
+			for _, step := range b.steps {
+				stepCtx := ctx
+				if sel != nil {
+					var ok bool
+					if stepCtx, ok = step.matchSelection(ctx, sel); !ok {
+						continue
+					}
+				}
+				value := step.field.GetPtr(obj)
+				for _, fn := range step.fns {
+					if ctx.Err() != nil {
+						return
+					}
+					fn(stepCtx, v.helper, value)
+				}
+			}
+		}
+	}
+	for _, field := range v.storage.getNormalizedNestedFields(t, v.isNestedValidated) {
+		ctx := ctx
+		if sel != nil {
+			ok, nested := sel.match(field.GetStructPath())
+			if !ok {
+				continue
+			}
+			ctx = withSelection(ctx, nested)
+		}
+		forEachStruct(reflect.ValueOf(field.GetPtr(obj)), func(obj any) {
+			v.normalize(ctx, obj)
+		})
+	}
+}
+
+// forEachStruct calls the fn with the pointer to each struct of the struct, pointer to struct,
+// slice of structs or map of structs value, the map values are copied and written back.
+func forEachStruct(rv reflect.Value, fn func(obj any)) {
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return
+		}
+		if rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Struct {
+			fn(rv.Interface())
+			return
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			elem := rv.Index(i)
+			if elem.Kind() == reflect.Struct && elem.CanAddr() {
+				elem = elem.Addr()
+			}
+			forEachStruct(elem, fn)
+		}
+	case reflect.Map:
+		iter := rv.MapRange()
+		for iter.Next() {
+			valPtr := reflect.New(iter.Value().Type())
+			valPtr.Elem().Set(iter.Value())
+			forEachStruct(valPtr, fn)
+			rv.SetMapIndex(iter.Key(), valPtr.Elem())
+		}
+	}
+}
+
+// copyKey is the key of the copied pointers, the pointers to the struct and its first field
+// have the same address, so the type is a part of the key.
+type copyKey struct {
+	ptr uintptr
+	t   reflect.Type
+}
+
+// deepCopy returns the deep copy of the obj, the unexported struct fields are copied shallowly.
+func deepCopy(obj any) any {
+	rv := reflect.ValueOf(obj)
+	if !rv.IsValid() {
+		return obj
+	}
+	return copyValue(rv, make(map[copyKey]reflect.Value)).Interface()
+}
+
+// copyValue returns the deep copy of the value, the copies of the pointers are shared,
+// so the copy keeps the pointers aliasing and cycles of the value.
+func copyValue(rv reflect.Value, copies map[copyKey]reflect.Value) reflect.Value {
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return rv
+		}
+		key := copyKey{ptr: rv.Pointer(), t: rv.Type()}
+		if c, ok := copies[key]; ok {
+			return c
+		}
+		c := reflect.New(rv.Type().Elem())
+		copies[key] = c
+		c.Elem().Set(copyValue(rv.Elem(), copies))
+		return c
+	case reflect.Struct:
+		c := reflect.New(rv.Type()).Elem()
+		c.Set(rv)
+		for i := 0; i < rv.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(copyValue(rv.Field(i), copies))
+			}
+		}
+		return c
+	case reflect.Slice:
+		if rv.IsNil() {
+			return rv
+		}
+		c := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			c.Index(i).Set(copyValue(rv.Index(i), copies))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(rv.Type()).Elem()
+		for i := 0; i < rv.Len(); i++ {
+			c.Index(i).Set(copyValue(rv.Index(i), copies))
+		}
+		return c
+	case reflect.Map:
+		if rv.IsNil() {
+			return rv
+		}
+		c := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(copyValue(iter.Key(), copies), copyValue(iter.Value(), copies))
+		}
+		return c
+	case reflect.Interface:
+		if rv.IsNil() {
+			return rv
+		}
+		c := reflect.New(rv.Type()).Elem()
+		c.Set(copyValue(rv.Elem(), copies))
+		return c
+	}
+	return rv
+}
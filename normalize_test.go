@@ -0,0 +1,214 @@
+package valigo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	guuid "github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type testNormalizeLine struct {
+	Name string
+}
+
+type testNormalizeOrder struct {
+	Name    string
+	Comment *string
+	Tags    []string
+	Labels  map[string]string
+	Lines   []testNormalizeLine
+	Main    *testNormalizeLine
+	ByName  map[string]testNormalizeLine
+}
+
+func newNormalizeValidator() *Validator {
+	v := New(WithAutoNestedValidation())
+	Configure[testNormalizeLine](v, func(c Configurator[testNormalizeLine], obj *testNormalizeLine) {
+		c.String(&obj.Name).Required().Trim()
+	})
+	Configure[testNormalizeOrder](v, func(c Configurator[testNormalizeOrder], obj *testNormalizeOrder) {
+		// the normalization rules are applied before the checks, regardless of the chain order
+		c.String(&obj.Name).MaxLen(3).Trim()
+		c.String(&obj.Comment).When(func(ctx context.Context, value any) bool {
+			comment := value.(**string)
+			return *comment != nil && len(**comment) > 0
+		}).Trim()
+		c.StringSlice(&obj.Tags).Trim()
+		c.Map(&obj.Labels).Keys().Trim()
+		c.Map(&obj.Labels).StringValues().Trim()
+	})
+	return v
+}
+
+func newNormalizeOrder() *testNormalizeOrder {
+	comment := " comment "
+	return &testNormalizeOrder{
+		Name:    " abc ",
+		Comment: &comment,
+		Tags:    []string{" a", "b "},
+		Labels:  map[string]string{" key ": " value "},
+		Lines:   []testNormalizeLine{{Name: " line "}},
+		Main:    &testNormalizeLine{Name: " main "},
+		ByName:  map[string]testNormalizeLine{"line": {Name: " by name "}},
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	v := newNormalizeValidator()
+	order := newNormalizeOrder()
+	assert.NoError(t, v.Normalize(context.Background(), order))
+	comment := "comment"
+	assert.Equal(t, &testNormalizeOrder{
+		Name:    "abc",
+		Comment: &comment,
+		Tags:    []string{"a", "b"},
+		Labels:  map[string]string{"key": "value"},
+		Lines:   []testNormalizeLine{{Name: "line"}},
+		Main:    &testNormalizeLine{Name: "main"},
+		ByName:  map[string]testNormalizeLine{"line": {Name: "by name"}},
+	}, order)
+}
+
+type testNormalizeShipment struct {
+	Main   testNormalizeLine
+	Backup *testNormalizeLine
+}
+
+func TestNormalizeNotValidatedNested(t *testing.T) {
+	v := New()
+	Configure[testNormalizeLine](v, func(c Configurator[testNormalizeLine], obj *testNormalizeLine) {
+		c.String(&obj.Name).Trim().Default("main")
+	})
+	Configure[testNormalizeShipment](v, func(c Configurator[testNormalizeShipment], obj *testNormalizeShipment) {
+		c.Struct(&obj.Main)
+	})
+	shipment := &testNormalizeShipment{Backup: &testNormalizeLine{Name: " backup "}}
+	assert.Empty(t, v.Validate(context.Background(), shipment))
+	assert.Equal(t, "main", shipment.Main.Name)
+	// the nested struct is never validated, so it isn't normalized
+	assert.Equal(t, " backup ", shipment.Backup.Name)
+}
+
+type testNormalizeCatalog struct {
+	Items map[string]testNormalizeLine
+}
+
+func TestNormalizeAutoNestedMap(t *testing.T) {
+	v := New(WithAutoNestedValidation())
+	Configure[testNormalizeLine](v, func(c Configurator[testNormalizeLine], obj *testNormalizeLine) {
+		c.String(&obj.Name).Trim().Required()
+	})
+	catalog := &testNormalizeCatalog{Items: map[string]testNormalizeLine{"a": {Name: " a "}, "b": {Name: "  "}}}
+	errs := v.ValidateTyped(context.Background(), catalog)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Items[b].Name", errs[0].Location)
+	assert.Equal(t, "a", catalog.Items["a"].Name)
+}
+
+func TestNormalizeMapStructValues(t *testing.T) {
+	v := New()
+	Configure[testNormalizeLine](v, func(c Configurator[testNormalizeLine], obj *testNormalizeLine) {
+		c.String(&obj.Name).Default("x").Required()
+	})
+	Configure[testNormalizeCatalog](v, func(c Configurator[testNormalizeCatalog], obj *testNormalizeCatalog) {
+		c.Map(&obj.Items).StructValues()
+	})
+	catalog := &testNormalizeCatalog{Items: map[string]testNormalizeLine{"a": {}}}
+	assert.Empty(t, v.ValidateTyped(context.Background(), catalog))
+	assert.Equal(t, "x", catalog.Items["a"].Name)
+}
+
+func TestValidateNormalizes(t *testing.T) {
+	v := newNormalizeValidator()
+	order := newNormalizeOrder()
+	assert.Empty(t, v.Validate(context.Background(), order))
+	assert.Equal(t, "abc", order.Name)
+	assert.Equal(t, "main", order.Main.Name)
+}
+
+func TestValidateDryRun(t *testing.T) {
+	v := newNormalizeValidator()
+	order := newNormalizeOrder()
+	ctx := ContextWithDryRun(context.Background())
+	assert.Empty(t, v.Validate(ctx, order))
+	assert.Equal(t, newNormalizeOrder(), order)
+
+	order.Name = " abcd "
+	errs := v.ValidateTyped(ctx, order)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Name", errs[0].Location)
+	assert.Equal(t, " abcd ", order.Name)
+}
+
+type testNormalizeQuery struct {
+	Currency string
+	Country  *string
+	PageSize int
+	Limit    *uint
+	ID       guuid.UUID
+	ParentID guuid.UUID
+}
+
+func TestValidateDefaults(t *testing.T) {
+	v := New()
+	id := guuid.MustParse("3b241101-e2bb-4255-8caf-4136c566a962")
+	Configure[testNormalizeQuery](v, func(c Configurator[testNormalizeQuery], obj *testNormalizeQuery) {
+		c.String(&obj.Currency).Default("EUR").Required()
+		c.String(&obj.Country).Default("DE").Required()
+		c.Number(&obj.PageSize).Default(20).Required()
+		c.Number(&obj.Limit).Default(uint(100))
+		c.UUID(&obj.ID).DefaultFunc(func() (guuid.UUID, error) {
+			return id, nil
+		}).Required()
+		c.UUID(&obj.ParentID).DefaultFunc(func() (guuid.UUID, error) {
+			return guuid.Nil, errors.New("failed")
+		}).Required()
+	})
+
+	query := &testNormalizeQuery{}
+	errs := v.ValidateTyped(context.Background(), query)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "ParentID", errs[0].Location)
+	limit, country := uint(100), "DE"
+	assert.Equal(t, &testNormalizeQuery{
+		Currency: "EUR",
+		Country:  &country,
+		PageSize: 20,
+		Limit:    &limit,
+		ID:       id,
+	}, query)
+
+	query = &testNormalizeQuery{Currency: "USD", PageSize: 5}
+	assert.NoError(t, v.Normalize(context.Background(), query))
+	assert.Equal(t, "USD", query.Currency)
+	assert.Equal(t, 5, query.PageSize)
+}
+
+func TestDefaultTypeMismatch(t *testing.T) {
+	v := New(WithConfigurationErrors())
+	Configure[testNormalizeQuery](v, func(c Configurator[testNormalizeQuery], obj *testNormalizeQuery) {
+		c.Number(&obj.PageSize).Default(int64(20))
+	})
+	assert.Error(t, v.Err())
+}
+
+func TestDeepCopy(t *testing.T) {
+	type node struct {
+		Name   string
+		Next   *node
+		Values []int
+		hidden *int
+	}
+	hidden := 1
+	n := &node{Name: "a", Values: []int{1}, hidden: &hidden}
+	n.Next = n
+	c := deepCopy(n).(*node)
+	assert.NotSame(t, n, c)
+	assert.Same(t, c, c.Next)
+	assert.Equal(t, "a", c.Name)
+	c.Values[0] = 2
+	assert.Equal(t, 1, n.Values[0])
+	assert.Same(t, n.hidden, c.hidden)
+}
@@ -12,9 +12,34 @@ import (
 const (
 	minLocaleKey          = "validation:num:Cannot be less than %v"
 	maxLocaleKey          = "validation:num:Cannot be greater than %v"
+	gtLocaleKey           = "validation:num:Should be greater than %v"
+	ltLocaleKey           = "validation:num:Should be less than %v"
 	requiredLocaleKey     = "validation:num:Should be fulfilled"
 	anyOfLocaleKey        = "validation:num:Only %v values is allowed"
 	anyOfIntervalLocalKey = "validation:num:Only interval[%v - %v] is allowed"
+	eqFieldLocaleKey      = "validation:num:Should be equal to %s"
+	neFieldLocaleKey      = "validation:num:Should not be equal to %s"
+	gtFieldLocaleKey      = "validation:num:Should be greater than %s"
+	gteFieldLocaleKey     = "validation:num:Should be greater than or equal to %s"
+	ltFieldLocaleKey      = "validation:num:Should be less than %s"
+	lteFieldLocaleKey     = "validation:num:Should be less than or equal to %s"
+)
+
+// Sentinel errors of the number rules, errors.Is reports whether the validation error is the error of the rule.
+var (
+	ErrRequired      = shared.NewCodeError(shared.KindNumber, shared.RuleRequired)
+	ErrMin           = shared.NewCodeError(shared.KindNumber, shared.RuleMin)
+	ErrMax           = shared.NewCodeError(shared.KindNumber, shared.RuleMax)
+	ErrGt            = shared.NewCodeError(shared.KindNumber, shared.RuleGt)
+	ErrLt            = shared.NewCodeError(shared.KindNumber, shared.RuleLt)
+	ErrAnyOf         = shared.NewCodeError(shared.KindNumber, shared.RuleAnyOf)
+	ErrAnyOfInterval = shared.NewCodeError(shared.KindNumber, shared.RuleAnyOfInterval)
+	ErrEqField       = shared.NewCodeError(shared.KindNumber, shared.RuleEqField)
+	ErrNeField       = shared.NewCodeError(shared.KindNumber, shared.RuleNeField)
+	ErrGtField       = shared.NewCodeError(shared.KindNumber, shared.RuleGtField)
+	ErrGteField      = shared.NewCodeError(shared.KindNumber, shared.RuleGteField)
+	ErrLtField       = shared.NewCodeError(shared.KindNumber, shared.RuleLtField)
+	ErrLteField      = shared.NewCodeError(shared.KindNumber, shared.RuleLteField)
 )
 
 func minT[T numbers](val T, min T) bool {
@@ -41,60 +66,121 @@ func anyOfIntervalT[T numbers](val T, begin, end T) bool {
 var _ BaseConfigurator = &baseConfigurator[int]{}
 
 type baseConfigurator[T numbers] struct {
-	c     *shared.FieldConfigurator[T]
-	field fmap.Field
-	h     shared.Helper
+	c         *shared.FieldConfigurator[T]
+	field     fmap.Field
+	valueType reflect.Type
+	h         shared.Helper
+	fields    fmap.Storage
+	obj       any
+	errFn     shared.ConfigErrorFn
+}
+
+// param converts the rule parameter to the number type T, the parameter type should be the field dereferenced type.
+// The parameters of the named number types fields, i.e. type Age int, have the named type.
+func (i *baseConfigurator[T]) param(value any) (T, bool) {
+	if i.valueType != reflect.TypeOf(value) {
+		return 0, false
+	}
+	if v, ok := value.(T); ok {
+		return v, true
+	}
+	return reflect.ValueOf(value).Convert(reflect.TypeOf(T(0))).Interface().(T), true
+}
+
+// Default sets the value to the zero number field in the normalization phase, before the validation rules,
+// the nil pointer field is set to the pointer to the value.
+func (i *baseConfigurator[T]) Default(value any) BaseConfigurator {
+	if i.valueType != reflect.TypeOf(value) {
+		i.errFn.Report(shared.NewConfigError(i.field, "field dereferenced type is %s, but default value type is %T", i.valueType, value))
+		return i
+	}
+	i.c.AppendDefault(shared.Rule{Name: shared.RuleDefault, Params: []any{value}}, func() (any, bool) {
+		return value, true
+	})
+	return i
 }
 
 // Max checks if the integer exceeds the maximum allowed number.
 func (i *baseConfigurator[T]) Max(maxNum any) BaseConfigurator {
-	if i.field.GetDereferencedType() != reflect.TypeOf(maxNum) {
-		panic(fmt.Sprintf("field dereferenced type is %s, but maxNum type is %s", i.field.GetDereferencedType().String(), reflect.TypeOf(maxNum).String()))
+	n, ok := i.param(maxNum)
+	if !ok {
+		i.errFn.Report(shared.NewConfigError(i.field, "field dereferenced type is %s, but maxNum type is %T", i.valueType, maxNum))
+		return i
 	}
-	i.c.Append(func(v T) bool {
-		return maxT[T](v, maxNum.(T))
+	i.c.AppendRule(shared.Rule{Name: shared.RuleMax, Params: []any{maxNum}}, func(v T) bool {
+		return maxT[T](v, n)
 	}, maxLocaleKey, maxNum)
 	return i
 }
 
 // Min checks if the integer is less than the minimum allowed number.
 func (i *baseConfigurator[T]) Min(minNum any) BaseConfigurator {
-	if i.field.GetDereferencedType() != reflect.TypeOf(minNum) {
-		panic(fmt.Sprintf("field dereferenced type is %s, but minNum type is %s", i.field.GetDereferencedType().String(), reflect.TypeOf(minNum).String()))
+	n, ok := i.param(minNum)
+	if !ok {
+		i.errFn.Report(shared.NewConfigError(i.field, "field dereferenced type is %s, but minNum type is %T", i.valueType, minNum))
+		return i
 	}
-	i.c.Append(func(v T) bool {
-		return minT[T](v, minNum.(T))
+	i.c.AppendRule(shared.Rule{Name: shared.RuleMin, Params: []any{minNum}}, func(v T) bool {
+		return minT[T](v, n)
 	}, minLocaleKey, minNum)
 	return i
 }
 
+// Gt checks if the integer is greater than the given number.
+func (i *baseConfigurator[T]) Gt(num any) BaseConfigurator {
+	n, ok := i.param(num)
+	if !ok {
+		i.errFn.Report(shared.NewConfigError(i.field, "field dereferenced type is %s, but num type is %T", i.valueType, num))
+		return i
+	}
+	i.c.AppendRule(shared.Rule{Name: shared.RuleGt, Params: []any{num}}, func(v T) bool {
+		return v > n
+	}, gtLocaleKey, num)
+	return i
+}
+
+// Lt checks if the integer is less than the given number.
+func (i *baseConfigurator[T]) Lt(num any) BaseConfigurator {
+	n, ok := i.param(num)
+	if !ok {
+		i.errFn.Report(shared.NewConfigError(i.field, "field dereferenced type is %s, but num type is %T", i.valueType, num))
+		return i
+	}
+	i.c.AppendRule(shared.Rule{Name: shared.RuleLt, Params: []any{num}}, func(v T) bool {
+		return v < n
+	}, ltLocaleKey, num)
+	return i
+}
+
 // Required checks if the integer value is not empty.
 func (i *baseConfigurator[T]) Required() BaseConfigurator {
-	i.c.Append(func(v T) bool {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleRequired}, func(v T) bool {
 		return true
 	}, requiredLocaleKey)
 	return i
 }
 
-func sliceCast[T numbers](slice []any) []T {
+// sliceCast converts the rule parameters to the number type T, see param.
+func (i *baseConfigurator[T]) sliceCast(slice []any) ([]T, error) {
 	ret := make([]T, 0, len(slice))
 	for _, val := range slice {
-		valTyped, ok := val.(T)
+		valTyped, ok := i.param(val)
 		if !ok {
-			panic(fmt.Errorf("can't cast value %v to number type %s", val, reflect.TypeOf(*new(T)).String()))
+			return nil, fmt.Errorf("can't cast value %v to number type %s", val, reflect.TypeOf(*new(T)).String())
 		}
 		ret = append(ret, valTyped)
 	}
-	return ret
+	return ret, nil
 }
 
 // AnyOf checks if the integer value is one of the allowed values.
 func (i *baseConfigurator[T]) AnyOf(allowed ...any) BaseConfigurator {
-	slice := sliceCast[T](allowed)
-	if i.field.GetDereferencedType().Kind() != reflect.TypeOf(slice).Elem().Kind() {
-		panic(fmt.Sprintf("field dereferenced type is %s, but minNum type is %s", i.field.GetDereferencedType().Kind().String(), reflect.TypeOf(allowed).Elem().Kind().String()))
+	slice, err := i.sliceCast(allowed)
+	if err != nil {
+		i.errFn.Report(shared.NewConfigError(i.field, "%v", err))
+		return i
 	}
-	i.c.Append(func(v T) bool {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleAnyOf, Params: allowed}, func(v T) bool {
 		return anyOfT[T](v, slice)
 	}, anyOfLocaleKey, allowed)
 	return i
@@ -102,22 +188,94 @@ func (i *baseConfigurator[T]) AnyOf(allowed ...any) BaseConfigurator {
 
 // AnyOfInterval checks if the integer value is one of the allowed values intervals.
 func (i *baseConfigurator[T]) AnyOfInterval(begin, end any) BaseConfigurator {
-	if i.field.GetDereferencedType() != reflect.TypeOf(begin) ||
-		i.field.GetDereferencedType() != reflect.TypeOf(end) {
-		panic(fmt.Sprintf("field dereferenced type is %s, but begin and end type is %s", i.field.GetDereferencedType().String(), reflect.TypeOf(reflect.TypeOf(end)).String()))
+	b, beginOk := i.param(begin)
+	e, endOk := i.param(end)
+	if !beginOk || !endOk {
+		i.errFn.Report(shared.NewConfigError(i.field, "field dereferenced type is %s, but begin and end types are %T and %T", i.valueType, begin, end))
+		return i
 	}
-	i.c.Append(func(v T) bool {
-		return anyOfIntervalT[T](v, begin.(T), end.(T))
+	i.c.AppendRule(shared.Rule{Name: shared.RuleAnyOfInterval, Params: []any{begin, end}}, func(v T) bool {
+		return anyOfIntervalT[T](v, b, e)
 	}, anyOfIntervalLocalKey, begin, end)
 	return i
 }
 
 // Custom allows for custom validation logic to be applied to the integer value.
-func (i *baseConfigurator[T]) Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error) BaseConfigurator {
+func (i *baseConfigurator[T]) Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error, opts ...shared.CustomOption) BaseConfigurator {
 	customHelper := shared.NewFieldCustomHelper(i.field, i.h)
 	i.c.CustomAppend(func(ctx context.Context, h shared.Helper, value any) []shared.Error {
 		return f(ctx, customHelper, value)
-	})
+	}, opts...)
+	return i
+}
+
+// appendCrossField appends the rule comparing the number value with the value of the other field.
+func (i *baseConfigurator[T]) appendCrossField(fieldPtr any, validationFn func(v, other T) bool, rule, localeKey string) {
+	cf, err := shared.NewCrossField(i.fields, i.obj, i.field, fieldPtr)
+	if err != nil {
+		i.errFn.Report(err)
+		return
+	}
+	derefFn, ok := getDerefFn(cf.Field.GetType())
+	if !ok {
+		i.errFn.Report(shared.NewConfigError(i.field, "cross-field rule other field %s type %s is not supported",
+			cf.Field.GetStructPath(), cf.Field.GetType().String()))
+		return
+	}
+	i.c.AppendCrossFieldRule(shared.Rule{Name: rule, Params: []any{cf.Field.GetStructPath()}}, func(value any) (T, bool) {
+		other, ok := derefFn(cf.Ptr(value))
+		if !ok {
+			return 0, false
+		}
+		return other.(T), true
+	}, validationFn, localeKey, i.h.FieldLocation(cf.Field))
+}
+
+// EqField checks if the number value is equal to the value of the other field.
+func (i *baseConfigurator[T]) EqField(fieldPtr any) BaseConfigurator {
+	i.appendCrossField(fieldPtr, func(v, other T) bool {
+		return v == other
+	}, shared.RuleEqField, eqFieldLocaleKey)
+	return i
+}
+
+// NeField checks if the number value is not equal to the value of the other field.
+func (i *baseConfigurator[T]) NeField(fieldPtr any) BaseConfigurator {
+	i.appendCrossField(fieldPtr, func(v, other T) bool {
+		return v != other
+	}, shared.RuleNeField, neFieldLocaleKey)
+	return i
+}
+
+// GtField checks if the number value is greater than the value of the other field.
+func (i *baseConfigurator[T]) GtField(fieldPtr any) BaseConfigurator {
+	i.appendCrossField(fieldPtr, func(v, other T) bool {
+		return v > other
+	}, shared.RuleGtField, gtFieldLocaleKey)
+	return i
+}
+
+// GteField checks if the number value is greater than or equal to the value of the other field.
+func (i *baseConfigurator[T]) GteField(fieldPtr any) BaseConfigurator {
+	i.appendCrossField(fieldPtr, func(v, other T) bool {
+		return v >= other
+	}, shared.RuleGteField, gteFieldLocaleKey)
+	return i
+}
+
+// LtField checks if the number value is less than the value of the other field.
+func (i *baseConfigurator[T]) LtField(fieldPtr any) BaseConfigurator {
+	i.appendCrossField(fieldPtr, func(v, other T) bool {
+		return v < other
+	}, shared.RuleLtField, ltFieldLocaleKey)
+	return i
+}
+
+// LteField checks if the number value is less than or equal to the value of the other field.
+func (i *baseConfigurator[T]) LteField(fieldPtr any) BaseConfigurator {
+	i.appendCrossField(fieldPtr, func(v, other T) bool {
+		return v <= other
+	}, shared.RuleLteField, lteFieldLocaleKey)
 	return i
 }
 
@@ -128,8 +286,25 @@ func (i *baseConfigurator[T]) When(whenFn func(ctx context.Context, value any) b
 	}
 	base := i.c.NewWithWhen(whenFn)
 	return &baseConfigurator[T]{
-		c:     base,
-		field: i.field,
-		h:     i.h,
+		c:         base,
+		field:     i.field,
+		valueType: i.valueType,
+		h:         i.h,
+		fields:    i.fields,
+		obj:       i.obj,
+		errFn:     i.errFn,
+	}
+}
+
+// Bail stops the evaluation of the following rules for the integer value at the first failed rule.
+func (i *baseConfigurator[T]) Bail() BaseConfigurator {
+	return &baseConfigurator[T]{
+		c:         i.c.Bail(),
+		field:     i.field,
+		valueType: i.valueType,
+		h:         i.h,
+		fields:    i.fields,
+		obj:       i.obj,
+		errFn:     i.errFn,
 	}
 }
@@ -15,6 +15,8 @@ type NumberBundle struct {
 	storage  fmap.Storage
 	obj      any
 	h        shared.Helper
+	errFn    shared.ConfigErrorFn
+	describe func(field fmap.Field, r shared.Rule)
 }
 
 // NewNumBundle creates a new intBundle instance.
@@ -25,6 +27,8 @@ func NewNumBundle(deps shared.BundleDependencies) *NumberBundle {
 		storage:  deps.Fields,
 		obj:      deps.Object,
 		h:        deps.Helper,
+		errFn:    deps.ErrorFn,
+		describe: deps.DescribeFn,
 	}
 }
 
@@ -122,10 +126,62 @@ var dereferenceFuncCache = map[reflect.Type]func(value any) (any, bool){
 	},
 }
 
+// getDerefFn returns the function dereferencing the pointer to the value of the number or pointer to number type t.
+// The values of the named number types, i.e. type Age int, are converted to their builtin number types.
+func getDerefFn(t reflect.Type) (func(value any) (any, bool), bool) {
+	if derefFn, ok := dereferenceFuncCache[reflect.PointerTo(t)]; ok {
+		return derefFn, true
+	}
+	valueType := t
+	if valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+	base, ok := builtinNumberTypes[valueType.Kind()]
+	if !ok {
+		return nil, false
+	}
+	zero := reflect.Zero(base).Interface()
+	return func(value any) (any, bool) {
+		rv := reflect.ValueOf(value)
+		for rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				return zero, false
+			}
+			rv = rv.Elem()
+		}
+		return rv.Convert(base).Interface(), true
+	}, true
+}
+
+// builtinNumberTypes are the builtin number types by their kinds.
+var builtinNumberTypes = map[reflect.Kind]reflect.Type{
+	reflect.Int:     reflect.TypeOf(int(0)),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+}
+
 type baseConfiguratorParams[T numbers] struct {
-	Field    fmap.Field
-	Helper   shared.Helper
-	AppendFn func(fn shared.FieldValidationFn)
+	Field     fmap.Field
+	ValueType reflect.Type
+	Helper    shared.Helper
+	AppendFn  func(fn shared.FieldValidationFn)
+	// Fields and Object are used to find the other fields for the cross-field rules,
+	// they are nil for the container elements.
+	Fields fmap.Storage
+	Object any
+	// ErrorFn reports the configuration errors, the configuration errors panic if it is nil.
+	ErrorFn shared.ConfigErrorFn
+	// DescribeFn receives the descriptors of the rules, it is optional.
+	DescribeFn shared.DescribeFn
 }
 
 func newBaseConfigurator[T numbers](p baseConfiguratorParams[T], derefFn func(value any) (any, bool)) *baseConfigurator[T] {
@@ -138,125 +194,218 @@ func newBaseConfigurator[T numbers](p baseConfiguratorParams[T], derefFn func(va
 		Helper: p.Helper,
 	})
 	return &baseConfigurator[T]{
-		field: p.Field,
-		h:     p.Helper,
+		field:     p.Field,
+		valueType: p.ValueType,
+		h:         p.Helper,
+		fields:    p.Fields,
+		obj:       p.Object,
+		errFn:     p.ErrorFn,
 		c: shared.NewFieldConfigurator[T](shared.FieldConfiguratorParams[T]{
-			Maker:    mk,
-			AppendFn: p.AppendFn,
+			Maker:      mk,
+			AppendFn:   p.AppendFn,
+			DescribeFn: p.DescribeFn,
+			Kind:       shared.KindNumber,
 		}),
 	}
 }
 
-// Number returns a FieldConfigurator instance for an int field.
-// It takes a pointer to an integer field as an argument.
-func (i *NumberBundle) Number(fieldPtr any) BaseConfigurator {
-	field, err := i.storage.GetFieldByPtr(i.obj, fieldPtr)
-	if err != nil {
-		panic(err)
-	}
-	derefFn, ok := dereferenceFuncCache[reflect.PointerTo(field.GetType())]
+// newConfigurator returns a BaseConfigurator instance for the number value of the type t.
+// The fields storage and the object are used by the cross-field rules, they are nil for the container elements.
+// The configuration error is reported for the unsupported type t, the rules of such value are discarded.
+func newConfigurator(t reflect.Type, field fmap.Field, h shared.Helper, appendFn func(fn shared.FieldValidationFn), fields fmap.Storage, obj any, errFn shared.ConfigErrorFn, describeFn shared.DescribeFn) BaseConfigurator {
+	derefFn, ok := getDerefFn(t)
 	if !ok {
-		panic("unsupported number field type")
+		errFn.Report(shared.NewConfigError(field, "type %s is not a number or pointer to number", t.String()))
+		return newDiscardConfigurator(field, h)
+	}
+	valueType := t
+	for valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
 	}
-	switch field.GetDereferencedType().Kind() {
+	switch valueType.Kind() {
 	case reflect.Int:
 		return newBaseConfigurator(baseConfiguratorParams[int]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	case reflect.Int8:
 		return newBaseConfigurator(baseConfiguratorParams[int8]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	case reflect.Int16:
 		return newBaseConfigurator(baseConfiguratorParams[int16]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	case reflect.Int32:
 		return newBaseConfigurator(baseConfiguratorParams[int32]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	case reflect.Int64:
 		return newBaseConfigurator(baseConfiguratorParams[int64]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	case reflect.Uint:
 		return newBaseConfigurator(baseConfiguratorParams[uint]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	case reflect.Uint8:
 		return newBaseConfigurator(baseConfiguratorParams[uint8]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	case reflect.Uint16:
 		return newBaseConfigurator(baseConfiguratorParams[uint16]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	case reflect.Uint32:
 		return newBaseConfigurator(baseConfiguratorParams[uint32]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	case reflect.Uint64:
 		return newBaseConfigurator(baseConfiguratorParams[uint64]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	case reflect.Float32:
 		return newBaseConfigurator(baseConfiguratorParams[float32]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	case reflect.Float64:
 		return newBaseConfigurator(baseConfiguratorParams[float64]{
-			Field:  field,
-			Helper: i.h,
-			AppendFn: func(fn shared.FieldValidationFn) {
-				i.appendFn(field, fn)
-			},
+			Field:      field,
+			ValueType:  valueType,
+			Helper:     h,
+			AppendFn:   appendFn,
+			Fields:     fields,
+			Object:     obj,
+			ErrorFn:    errFn,
+			DescribeFn: describeFn,
 		}, derefFn)
 	default:
-		panic("unsupported number field type")
+		errFn.Report(shared.NewConfigError(field, "type %s is not a number or pointer to number", t.String()))
+		return newDiscardConfigurator(field, h)
 	}
-	return nil
+}
+
+// newDiscardConfigurator returns a BaseConfigurator instance of the invalid field configuration,
+// it discards the rules and the configuration errors.
+func newDiscardConfigurator(field fmap.Field, h shared.Helper) BaseConfigurator {
+	return newBaseConfigurator(baseConfiguratorParams[int]{
+		Field:    field,
+		Helper:   h,
+		AppendFn: shared.DiscardFieldValidationFn,
+		ErrorFn:  shared.DiscardConfigError,
+	}, dereferenceFuncCache[reflect.TypeOf(new(int))])
+}
+
+// Number returns a FieldConfigurator instance for an int field.
+// It takes a pointer to an integer field as an argument.
+// The configuration error is reported for the unknown field or not a number field,
+// the rules of such field are discarded.
+func (i *NumberBundle) Number(fieldPtr any) BaseConfigurator {
+	field, err := i.storage.GetFieldByPtr(i.obj, fieldPtr)
+	if err != nil {
+		i.errFn.Report(shared.NewConfigError(nil, "number field: %v", err))
+		return newDiscardConfigurator(field, i.h)
+	}
+	return newConfigurator(field.GetType(), field, i.h, func(fn shared.FieldValidationFn) {
+		i.appendFn(field, fn)
+	}, i.storage, i.obj, i.errFn, shared.NewFieldDescribeFn(field, i.describe))
+}
+
+// ElementConfiguratorParams is a struct that represents the parameters for the number element configurator.
+type ElementConfiguratorParams struct {
+	// Type is the type of the element, number or pointer to number.
+	Type reflect.Type
+	// Field is the field that contains the elements, i.e. map or slice field.
+	Field fmap.Field
+	// Helper is the Helper implementation used for validation.
+	Helper shared.Helper
+	// AppendFn is a function that appends an element validation function,
+	// the function takes a pointer to the element value.
+	AppendFn func(fn shared.FieldValidationFn)
+	// ErrorFn reports the configuration errors, the configuration errors panic if it is nil.
+	ErrorFn shared.ConfigErrorFn
+	// DescribeFn receives the descriptors of the element rules, it is optional.
+	DescribeFn shared.DescribeFn
+}
+
+// NewElementConfigurator returns a BaseConfigurator instance for number elements of the container field,
+// such as map values.
+func NewElementConfigurator(p ElementConfiguratorParams) BaseConfigurator {
+	return newConfigurator(p.Type, p.Field, p.Helper, p.AppendFn, nil, nil, p.ErrorFn, p.DescribeFn)
 }
@@ -15,6 +15,8 @@ type ptrNumbers interface {
 }
 
 type BaseConfigurator interface {
+	// Default sets the value to the zero number field in the normalization phase.
+	Default(value any) BaseConfigurator
 	// Required checks if the integer is not empty.
 	Required() BaseConfigurator
 
@@ -30,8 +32,35 @@ type BaseConfigurator interface {
 	// Min checks if the integer is not less than the given minimum number.
 	Min(any) BaseConfigurator
 
-	Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error) BaseConfigurator
+	// Gt checks if the integer is greater than the given number.
+	Gt(any) BaseConfigurator
+
+	// Lt checks if the integer is less than the given number.
+	Lt(any) BaseConfigurator
+
+	// EqField checks if the number is equal to the value of the other field of the struct.
+	EqField(fieldPtr any) BaseConfigurator
+
+	// NeField checks if the number is not equal to the value of the other field of the struct.
+	NeField(fieldPtr any) BaseConfigurator
+
+	// GtField checks if the number is greater than the value of the other field of the struct.
+	GtField(fieldPtr any) BaseConfigurator
+
+	// GteField checks if the number is greater than or equal to the value of the other field of the struct.
+	GteField(fieldPtr any) BaseConfigurator
+
+	// LtField checks if the number is less than the value of the other field of the struct.
+	LtField(fieldPtr any) BaseConfigurator
+
+	// LteField checks if the number is less than or equal to the value of the other field of the struct.
+	LteField(fieldPtr any) BaseConfigurator
+
+	Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error, opts ...shared.CustomOption) BaseConfigurator
 	When(whenFn func(ctx context.Context, value any) bool) BaseConfigurator
+
+	// Bail stops the evaluation of the following rules at the first failed rule.
+	Bail() BaseConfigurator
 }
 
 // NumberBundleConfigurator is a builder interface for a bundle of uint fields.
@@ -0,0 +1,48 @@
+// Package openapi generates the OpenAPI 3.1 components of the types registered in the valigo.Validator.
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/insei/valigo"
+)
+
+const (
+	// Version is the OpenAPI version of the generated components.
+	Version = "3.1.0"
+	// SchemasRefPrefix is the prefix of the references to the components schemas.
+	SchemasRefPrefix = "#/components/schemas/"
+)
+
+// Components is the OpenAPI components object.
+// The schemas are JSON Schemas (Draft 2020-12), the dialect of the OpenAPI 3.1 schema objects.
+type Components struct {
+	Schemas map[string]*valigo.JSONSchema `json:"schemas,omitempty"`
+}
+
+// NewComponents returns the components with the schemas of all types registered in the validator
+// and the types of the objs (struct or pointer to struct values), i.e. the types configured from the struct tags
+// that was not validated yet. The anonymous struct types are skipped, the schemas are named by the type names, the property names are the field
+// locations configured with valigo.WithFieldLocationNamingFn.
+func NewComponents(v *valigo.Validator, objs ...any) (*Components, error) {
+	var types []reflect.Type
+	for _, t := range v.ConfiguredTypes() {
+		// the anonymous structs have no names to be referenced by
+		if t.Elem().Name() != "" {
+			types = append(types, t)
+		}
+	}
+	for _, obj := range objs {
+		t := reflect.TypeOf(obj)
+		if t == nil {
+			return nil, fmt.Errorf("object is nil")
+		}
+		types = append(types, t)
+	}
+	schemas, err := v.JSONSchemas(SchemasRefPrefix, types...)
+	if err != nil {
+		return nil, err
+	}
+	return &Components{Schemas: schemas}, nil
+}
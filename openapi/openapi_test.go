@@ -0,0 +1,94 @@
+package openapi
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/insei/fmap/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insei/valigo"
+)
+
+type Address struct {
+	City string `json:"city"`
+}
+
+type User struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Email   string    `json:"email"`
+	Age     int       `json:"age"`
+	Role    string    `json:"role"`
+	Address *Address  `json:"address"`
+}
+
+type Tagged struct {
+	Name string `json:"name" valigo:"required,max=10"`
+}
+
+func TestNewComponents(t *testing.T) {
+	v := valigo.New(
+		valigo.WithTagsConfiguration(),
+		valigo.WithFieldLocationNamingFn(func(field fmap.Field) string {
+			return field.GetTagPath("json", false)
+		}),
+	)
+	valigo.Configure[Address](v, func(c valigo.Configurator[Address], obj *Address) {
+		c.String(&obj.City).Required()
+	})
+	valigo.Configure[User](v, func(c valigo.Configurator[User], obj *User) {
+		c.UUID(&obj.ID).Required()
+		c.String(&obj.Name).MaxRunes(64).Regexp(regexp.MustCompile(`^[a-z]+$`))
+		c.String(&obj.Email).Email()
+		c.Number(&obj.Age).Min(18).Max(120)
+		c.String(&obj.Role).AnyOf("admin", "user")
+		c.Struct(&obj.Address)
+	})
+	valigo.Configure[struct{ Name string }](v, func(c valigo.Configurator[struct{ Name string }], obj *struct{ Name string }) {
+		c.String(&obj.Name).Required()
+	})
+
+	components, err := NewComponents(v, Tagged{})
+	assert.NoError(t, err)
+	actual, err := json.Marshal(components)
+	assert.NoError(t, err)
+	expected := `{
+		"schemas": {
+			"Address": {
+				"type": "object",
+				"properties": {"city": {"type": "string", "minLength": 1}},
+				"required": ["city"]
+			},
+			"User": {
+				"type": "object",
+				"properties": {
+					"id": {"type": "string", "format": "uuid"},
+					"name": {"type": "string", "pattern": "^[a-z]+$", "maxLength": 64},
+					"email": {"type": "string", "format": "email"},
+					"age": {"type": "integer", "minimum": 18, "maximum": 120},
+					"role": {"type": "string", "enum": ["admin", "user"]},
+					"address": {"$ref": "#/components/schemas/Address"}
+				},
+				"required": ["id"]
+			},
+			"Tagged": {
+				"type": "object",
+				"properties": {"name": {
+					"type": "string",
+					"minLength": 1,
+					"x-valigo-rules": [{"name": "maxLen", "params": [10]}]
+				}},
+				"required": ["name"]
+			}
+		}
+	}`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func TestNewComponentsInvalidType(t *testing.T) {
+	_, err := NewComponents(valigo.New(), "")
+	assert.Error(t, err)
+}
@@ -50,3 +50,67 @@ func WithErrorsTransformer(fn func(errs []shared.Error) []error) Option {
 		}
 	})
 }
+
+// WithAutoNestedValidation returns an Option that enables automatic validation of the nested struct fields
+// with the rules registered for their types. Fields configured explicitly with Configurator.Struct
+// are validated only once.
+func WithAutoNestedValidation() Option {
+	return optionFunc(func(v *Validator) {
+		v.autoNested = true
+	})
+}
+
+// WithConfigurationErrors returns an Option that makes Configure collect the configuration errors
+// instead of panicking, the collected errors are returned by Validator.Err.
+func WithConfigurationErrors() Option {
+	return optionFunc(func(v *Validator) {
+		if v.configErrs == nil {
+			v.configErrs = &configErrors{}
+		}
+	})
+}
+
+// WithMaxErrors returns an Option that limits the count of errors returned by the Validator,
+// the validation stops when the limit is reached. Zero value means no limit.
+func WithMaxErrors(maxErrors int) Option {
+	return optionFunc(func(v *Validator) {
+		if maxErrors >= 0 {
+			v.maxErrors = maxErrors
+		}
+	})
+}
+
+// WithFailFast returns an Option that stops the validation at the first error.
+func WithFailFast() Option {
+	return WithMaxErrors(1)
+}
+
+// WithAsyncConcurrency returns an Option that enables the concurrent execution of the async custom rules
+// (see shared.WithAsync) with the limit of the concurrently running rules. Without this option
+// async rules run sequentially. The errors are returned in the rules declaration order.
+func WithAsyncConcurrency(limit int) Option {
+	return optionFunc(func(v *Validator) {
+		if limit > 0 {
+			v.asyncLimit = limit
+		}
+	})
+}
+
+// WithTagsConfiguration returns an Option that configures the types from the valigo struct tags
+// on the first validation of the type, see ConfigureFromTags. Only the types without registered rules
+// are configured, the tags configuration errors are returned as validation errors.
+func WithTagsConfiguration() Option {
+	return optionFunc(func(v *Validator) {
+		v.tagDialect = valigoDialect
+	})
+}
+
+// WithValidateTagsConfiguration returns an Option that configures the types from the go-playground/validator
+// compatible struct tags on the first validation of the type, see ConfigureFromValidateTags.
+// Only the types without registered rules are configured, the tags configuration errors are returned
+// as validation errors. It replaces the WithTagsConfiguration option.
+func WithValidateTagsConfiguration() Option {
+	return optionFunc(func(v *Validator) {
+		v.tagDialect = validateDialect
+	})
+}
@@ -0,0 +1,142 @@
+package valigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/insei/valigo/shared"
+)
+
+// selectionCtxKey is a context key of the fields selection for the partial validation.
+type selectionCtxKey struct{}
+
+// selection is a set of struct paths of the fields selected for the partial validation,
+// nil selection means all fields are selected.
+type selection map[string]struct{}
+
+// withSelection returns a copy of the ctx with the fields selection.
+func withSelection(ctx context.Context, sel selection) context.Context {
+	return context.WithValue(ctx, selectionCtxKey{}, sel)
+}
+
+// selectionFromContext returns the fields selection from the ctx, nil if all fields are selected.
+func selectionFromContext(ctx context.Context) selection {
+	sel, _ := ctx.Value(selectionCtxKey{}).(selection)
+	return sel
+}
+
+// match checks if the rules of the field with the struct path should run.
+// The field matches when the field itself or one of its parents is selected, in such case
+// the nested selection is nil and all rules of the nested fields run. The field matches also
+// when some of its nested fields are selected, the nested selection contains their relative paths.
+func (s selection) match(path string) (bool, selection) {
+	for p := path; ; {
+		if _, ok := s[p]; ok {
+			return true, nil
+		}
+		i := strings.LastIndexByte(p, '.')
+		if i < 0 {
+			break
+		}
+		p = p[:i]
+	}
+	var nested selection
+	prefix := path + "."
+	for p := range s {
+		if !strings.HasPrefix(p, prefix) {
+			continue
+		}
+		if nested == nil {
+			nested = make(selection)
+		}
+		nested[strings.TrimPrefix(p, prefix)] = struct{}{}
+	}
+	return nested != nil, nested
+}
+
+// matchAny checks if at least one of the struct paths matches the selection.
+func (s selection) matchAny(paths []string) bool {
+	for _, path := range paths {
+		if ok, _ := s.match(path); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getFieldPaths returns the struct paths of the fields of the object,
+// the fields are specified by pointers to fields or struct paths.
+func getFieldPaths(obj any, fields []any) ([]string, error) {
+	paths := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if path, ok := f.(string); ok {
+			if !hasFieldPath(obj, path) {
+				return nil, fmt.Errorf("failed to find field by struct path %q", path)
+			}
+			paths = append(paths, path)
+			continue
+		}
+		field, err := getFieldByPtr(obj, f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find field by pointer: %w", err)
+		}
+		paths = append(paths, field.GetStructPath())
+	}
+	return paths, nil
+}
+
+// hasFieldPath checks if the struct path is the path of the field of the object, the paths of the fields
+// of the pointers to structs, slices of structs and maps of structs continue in the nested struct type.
+func hasFieldPath(obj any, path string) bool {
+	fields, err := getFields(obj)
+	if err != nil {
+		return false
+	}
+	if _, ok := fields.Find(path); ok {
+		return true
+	}
+	for i := strings.IndexByte(path, '.'); i >= 0; {
+		if field, ok := fields.Find(path[:i]); ok {
+			if t, ok := getNestedStructType(field.GetType()); ok && hasFieldPath(reflect.New(t.Elem()).Interface(), path[i+1:]) {
+				return true
+			}
+		}
+		j := strings.IndexByte(path[i+1:], '.')
+		if j < 0 {
+			break
+		}
+		i += j + 1
+	}
+	return false
+}
+
+// ErrInvalidPartialFields is the error of the partial validation with the fields that are not the fields of the object.
+var ErrInvalidPartialFields = errors.New("invalid partial validation fields")
+
+// ValidatePartialTyped validates only the selected fields of the object.
+// The fields are specified by pointers to fields of the object or struct paths (i.e. "Address.City"),
+// the invalid pointer or unknown struct path is returned as the single error wrapping ErrInvalidPartialFields.
+// Only the rules attached to the selected fields, their nested fields and parents run,
+// struct level Custom rules run only if they depend on the selected fields, see shared.WithDependsOn.
+// The ctx cancellation is handled the same way as in ValidateTyped.
+func (v *Validator) ValidatePartialTyped(ctx context.Context, obj any, fields ...any) []shared.Error {
+	paths, err := getFieldPaths(obj, fields)
+	if err != nil {
+		err = fmt.Errorf("%w: %w", ErrInvalidPartialFields, err)
+		return []shared.Error{{Message: err.Error(), Err: err}}
+	}
+	sel := make(selection, len(paths))
+	for _, path := range paths {
+		sel[path] = struct{}{}
+	}
+	return v.validateRoot(withSelection(ctx, sel), obj)
+}
+
+// ValidatePartial is similar to ValidatePartialTyped, but it returns a slice of error
+// objects instead of shared.Error objects.
+func (v *Validator) ValidatePartial(ctx context.Context, obj any, fields ...any) []error {
+	return v.transformError(v.ValidatePartialTyped(ctx, obj, fields...))
+}
@@ -0,0 +1,142 @@
+package valigo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insei/valigo/shared"
+)
+
+type profile struct {
+	Name       string
+	Email      string
+	Password   string
+	Confirm    string
+	Address    address
+	PtrAddress *address
+}
+
+func TestValidatePartial(t *testing.T) {
+	v := New()
+	Configure[address](v, func(c Configurator[address], obj *address) {
+		c.String(&obj.City).Required()
+		c.String(&obj.Street).Required()
+	})
+	Configure[profile](v, func(c Configurator[profile], obj *profile) {
+		c.String(&obj.Name).Required()
+		c.String(&obj.Email).Required()
+		c.String(&obj.Address.City).Required()
+		c.Struct(&obj.PtrAddress)
+		c.Custom(func(ctx context.Context, h shared.StructCustomHelper, obj *profile) []shared.Error {
+			if obj.Password != obj.Confirm {
+				return []shared.Error{h.ErrorT(ctx, &obj.Confirm, obj.Confirm, "passwords mismatch")}
+			}
+			return nil
+		}, shared.WithDependsOn(&obj.Password, "Confirm"))
+		c.Custom(func(ctx context.Context, h shared.StructCustomHelper, obj *profile) []shared.Error {
+			return []shared.Error{h.ErrorT(ctx, &obj.Name, obj.Name, "always")}
+		})
+	})
+	street := ""
+	obj := &profile{Password: "a", PtrAddress: &address{Street: &street}}
+
+	testCases := []struct {
+		name      string
+		fields    []any
+		locations []string
+	}{
+		{
+			name:      "field pointer",
+			fields:    []any{&obj.Name},
+			locations: []string{"Name"},
+		},
+		{
+			name:      "struct path",
+			fields:    []any{"Email"},
+			locations: []string{"Email"},
+		},
+		{
+			name:      "nested value struct field",
+			fields:    []any{&obj.Address.City},
+			locations: []string{"Address.City"},
+		},
+		{
+			name:      "parent of nested value struct field",
+			fields:    []any{"Address"},
+			locations: []string{"Address.City"},
+		},
+		{
+			name:      "nested struct",
+			fields:    []any{&obj.PtrAddress},
+			locations: []string{"PtrAddress.City", "PtrAddress.Street"},
+		},
+		{
+			name:      "nested struct field",
+			fields:    []any{"PtrAddress.Street"},
+			locations: []string{"PtrAddress.Street"},
+		},
+		{
+			name:      "custom dependency",
+			fields:    []any{&obj.Password},
+			locations: []string{"Confirm"},
+		},
+		{
+			name: "no fields",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := v.ValidatePartialTyped(context.Background(), obj, tc.fields...)
+			var locations []string
+			for _, err := range errs {
+				locations = append(locations, err.Location)
+			}
+			assert.Equal(t, tc.locations, locations)
+		})
+	}
+	assert.Len(t, v.ValidateTyped(context.Background(), obj), 7)
+	assert.Len(t, v.ValidatePartial(context.Background(), obj, &obj.Name, &obj.Email), 2)
+}
+
+func TestValidatePartialInvalidField(t *testing.T) {
+	v := New()
+	obj := &profile{}
+	errs := v.ValidatePartialTyped(context.Background(), obj, new(string))
+	assert.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrInvalidPartialFields)
+	assert.ErrorIs(t, v.ValidatePartialE(context.Background(), obj, new(string)), ErrInvalidPartialFields)
+
+	for _, path := range []string{"Nmae", "Address.Town", "PtrAddress.Town", "Name.Length"} {
+		errs = v.ValidatePartialTyped(context.Background(), obj, path)
+		assert.Len(t, errs, 1, path)
+		assert.ErrorIs(t, errs[0], ErrInvalidPartialFields, path)
+	}
+}
+
+func TestCustomDependsOnInvalidPath(t *testing.T) {
+	v := New()
+	err := ConfigureE[profile](v, func(c Configurator[profile], obj *profile) {
+		c.Custom(func(ctx context.Context, h shared.StructCustomHelper, obj *profile) []shared.Error {
+			return nil
+		}, shared.WithDependsOn("Confrim"))
+	})
+	assert.ErrorIs(t, err, shared.ErrInvalidConfiguration)
+	assert.ErrorContains(t, err, `"Confrim"`)
+}
+
+func TestSelectionMatch(t *testing.T) {
+	sel := selection{"Address.City": {}, "Name": {}}
+	ok, nested := sel.match("Name")
+	assert.True(t, ok)
+	assert.Nil(t, nested)
+	ok, nested = sel.match("Address")
+	assert.True(t, ok)
+	assert.Equal(t, selection{"City": {}}, nested)
+	ok, _ = sel.match("Email")
+	assert.False(t, ok)
+	ok, nested = selection{"Address": {}}.match("Address.City")
+	assert.True(t, ok)
+	assert.Nil(t, nested)
+}
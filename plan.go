@@ -0,0 +1,141 @@
+package valigo
+
+import (
+	"context"
+
+	"github.com/insei/fmap/v3"
+
+	"github.com/insei/valigo/shared"
+)
+
+// condition is a struct level condition shared by all rules configured with the same Configurator.
+type condition struct {
+	// enabler is the composition of the When functions, nil means no When function.
+	enabler func(ctx context.Context, obj any) bool
+	// groups is a list of the validation groups, all of them should be selected for the validation.
+	groups []string
+}
+
+// newCondition returns a new condition for the enabler function, nil enabler means no condition.
+func newCondition(enabler func(ctx context.Context, obj any) bool) *condition {
+	if enabler == nil {
+		return nil
+	}
+	return &condition{enabler: enabler}
+}
+
+// withEnabler returns a new condition with the same groups and the enabler.
+func (c *condition) withEnabler(enabler func(ctx context.Context, obj any) bool) *condition {
+	if c == nil {
+		return newCondition(enabler)
+	}
+	return &condition{enabler: enabler, groups: c.groups}
+}
+
+// withGroup returns a new condition with the same enabler and the group added.
+func (c *condition) withGroup(group string) *condition {
+	if c == nil {
+		return &condition{groups: []string{group}}
+	}
+	groups := make([]string, 0, len(c.groups)+1)
+	return &condition{enabler: c.enabler, groups: append(append(groups, c.groups...), group)}
+}
+
+// getEnabler returns the enabler of the condition, nil if there is no enabler.
+func (c *condition) getEnabler() func(ctx context.Context, obj any) bool {
+	if c == nil {
+		return nil
+	}
+	return c.enabler
+}
+
+// rule represents a single registered validation rule.
+type rule struct {
+	// cond is the condition of the rule, nil means the rule is always enabled.
+	cond *condition
+	// field is the field validated by the rule, nil for struct level rules.
+	field fmap.Field
+	// dependsOn is a list of struct paths of the fields the struct level rule depends on.
+	dependsOn []string
+	// fn is the validation function, it takes the pointer to the field value
+	// or the pointer to the struct for struct level rules.
+	fn shared.FieldValidationFn
+}
+
+// descriptor is the descriptor of the rule registered for the type.
+type descriptor struct {
+	// cond is the condition of the rule, nil means the rule is always enabled.
+	cond *condition
+	// field is the field described by the rule, nil for struct level rules.
+	field fmap.Field
+	// rule is the rule descriptor.
+	rule shared.Rule
+}
+
+// planStep is a set of validation functions for a single field (or struct itself),
+// the field pointer is resolved once for all functions.
+type planStep struct {
+	field     fmap.Field
+	dependsOn []string
+	fns       []shared.FieldValidationFn
+}
+
+// planBlock is a set of steps that shares the same condition,
+// the condition is evaluated once for all steps.
+type planBlock struct {
+	enabler func(ctx context.Context, obj any) bool
+	groups  []string
+	steps   []planStep
+}
+
+// plan is a compiled flat validation plan for a struct type.
+type plan struct {
+	blocks []planBlock
+}
+
+// compilePlan compiles rules to the validation plan.
+// Adjacent rules with the same condition are grouped to blocks and adjacent rules of the same field
+// are grouped to steps, so the rules run in the declaration order.
+// Struct level rules with dependencies are never merged, each of them has its own step.
+func compilePlan(rules []rule) *plan {
+	p := &plan{}
+	var (
+		g        *planBlock
+		lastCond *condition
+	)
+	for _, r := range rules {
+		if g == nil || r.cond != lastCond {
+			b := planBlock{}
+			if r.cond != nil {
+				b.enabler = r.cond.enabler
+				b.groups = r.cond.groups
+			}
+			p.blocks = append(p.blocks, b)
+			g, lastCond = &p.blocks[len(p.blocks)-1], r.cond
+		}
+		if len(r.dependsOn) > 0 {
+			g.steps = append(g.steps, planStep{dependsOn: r.dependsOn, fns: []shared.FieldValidationFn{r.fn}})
+			continue
+		}
+		if n := len(g.steps); n == 0 || g.steps[n-1].field != r.field || len(g.steps[n-1].dependsOn) > 0 {
+			g.steps = append(g.steps, planStep{field: r.field})
+		}
+		last := &g.steps[len(g.steps)-1]
+		last.fns = append(last.fns, r.fn)
+	}
+	return p
+}
+
+// matchSelection checks if the step should run for the partial validation with the fields selection.
+// It returns the context with the nested fields selection for the step.
+// Struct level steps run only if they depend on the selected fields.
+func (s *planStep) matchSelection(ctx context.Context, sel selection) (context.Context, bool) {
+	if s.field == nil {
+		return ctx, sel.matchAny(s.dependsOn)
+	}
+	ok, nested := sel.match(s.field.GetStructPath())
+	if !ok {
+		return ctx, false
+	}
+	return withSelection(ctx, nested), true
+}
@@ -0,0 +1,87 @@
+package valigo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/insei/fmap/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insei/valigo/shared"
+)
+
+func TestCompilePlan(t *testing.T) {
+	strg, _ := fmap.Get[user]()
+	name := strg.MustFind("Name")
+	age := strg.MustFind("Age")
+	cond := newCondition(func(ctx context.Context, obj any) bool { return true })
+	var calls []string
+	newFn := func(id string) shared.FieldValidationFn {
+		return func(ctx context.Context, h shared.Helper, v any) []shared.Error {
+			calls = append(calls, id)
+			return nil
+		}
+	}
+	p := compilePlan([]rule{
+		{field: name, fn: newFn("name1")},
+		{field: name, fn: newFn("name2")},
+		{cond: cond, field: age, fn: newFn("age1")},
+		{field: age, fn: newFn("age2")},
+		{field: name, fn: newFn("name3")},
+		{fn: newFn("struct")},
+		{cond: cond, field: age, fn: newFn("age3")},
+	})
+	assert.Len(t, p.blocks, 4)
+	assert.Nil(t, p.blocks[0].enabler)
+	assert.NotNil(t, p.blocks[1].enabler)
+	assert.Nil(t, p.blocks[2].enabler)
+	assert.NotNil(t, p.blocks[3].enabler)
+	assert.Len(t, p.blocks[0].steps, 1)
+	assert.Len(t, p.blocks[0].steps[0].fns, 2)
+	assert.Len(t, p.blocks[2].steps, 3)
+	for _, b := range p.blocks {
+		for _, step := range b.steps {
+			for _, fn := range step.fns {
+				fn(context.Background(), nil, nil)
+			}
+		}
+	}
+	assert.Equal(t, []string{"name1", "name2", "age1", "age2", "name3", "struct", "age3"}, calls)
+}
+
+func TestValidatorPlanDeclarationOrder(t *testing.T) {
+	v := New()
+	Configure[user](v, func(c Configurator[user], obj *user) {
+		c.String(&obj.Name).Required()
+		c.Number(&obj.Age).Min(1)
+		c.String(&obj.Name).MinLen(3)
+	})
+	errs := v.ValidateTyped(context.Background(), &user{})
+	assert.Len(t, errs, 3)
+	assert.Equal(t, []string{"Name", "Age", "Name"}, []string{errs[0].Location, errs[1].Location, errs[2].Location})
+
+	v = New(WithFailFast())
+	Configure[user](v, func(c Configurator[user], obj *user) {
+		c.Number(&obj.Age).Min(1)
+		c.String(&obj.Name).Required()
+		c.Number(&obj.Age).Max(10)
+	})
+	errs = v.ValidateTyped(context.Background(), &user{})
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Age", errs[0].Location)
+}
+
+func TestValidatorPlanCondition(t *testing.T) {
+	v := New()
+	Configure[user](v, func(c Configurator[user], obj *user) {
+		c.String(&obj.Name).Required()
+		c.When(func(ctx context.Context, obj *user) bool {
+			return obj.Age > 0
+		}).Number(&obj.Age).Max(18)
+		c.Number(&obj.Age).Min(1)
+	})
+	errs := v.ValidateTyped(context.Background(), &user{})
+	assert.Len(t, errs, 2)
+	errs = v.ValidateTyped(context.Background(), &user{Name: "Alex", Age: 25})
+	assert.Len(t, errs, 1)
+}
@@ -0,0 +1,117 @@
+// Package problem renders the validation errors as the RFC 9457 problem details (application/problem+json).
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/insei/valigo"
+	"github.com/insei/valigo/shared"
+)
+
+const (
+	// ContentType is the media type of the problem details.
+	ContentType = "application/problem+json"
+
+	titleLocaleKey  = "validation:problem:Validation failed"
+	detailLocaleKey = "validation:problem:Validation errors count: %d"
+)
+
+// Problem is the problem details object of the validation errors with the "errors" extension member.
+type Problem struct {
+	// Type is the URI reference identifying the problem type, the omitted type means "about:blank".
+	Type string `json:"type,omitempty"`
+	// Title is the translated short summary of the problem type.
+	Title string `json:"title"`
+	// Status is the HTTP status code, http.StatusUnprocessableEntity by default.
+	Status int `json:"status"`
+	// Detail is the translated explanation of the problem occurrence.
+	Detail string `json:"detail,omitempty"`
+	// Instance is the URI reference identifying the problem occurrence.
+	Instance string `json:"instance,omitempty"`
+	// Errors are the validation errors.
+	Errors []Error `json:"errors"`
+}
+
+// Error is the validation error of the Problem.
+type Error struct {
+	// Location is the field location, see valigo.WithFieldLocationNamingFn, it is empty for the struct errors.
+	Location string `json:"location,omitempty"`
+	// Code is the error code of the failed rule, i.e. "string.max_len".
+	Code string `json:"code,omitempty"`
+	// Message is the translated error message.
+	Message string `json:"message"`
+	// Params are the parameters of the failed rule, i.e. {"max": 64}.
+	Params *shared.Params `json:"params,omitempty"`
+}
+
+// Option is an interface that defines a method for applying options to the Problem.
+type Option interface {
+	apply(p *Problem)
+}
+
+// optionFunc is a function type that implements the Option interface.
+type optionFunc func(p *Problem)
+
+// apply implements the Option interface for optionFunc.
+func (f optionFunc) apply(p *Problem) {
+	f(p)
+}
+
+// WithType returns an Option that sets the problem type URI.
+func WithType(typeURI string) Option {
+	return optionFunc(func(p *Problem) {
+		p.Type = typeURI
+	})
+}
+
+// WithStatus returns an Option that sets the HTTP status code of the problem.
+func WithStatus(status int) Option {
+	return optionFunc(func(p *Problem) {
+		p.Status = status
+	})
+}
+
+// WithInstance returns an Option that sets the problem occurrence URI, i.e. the request path.
+func WithInstance(instance string) Option {
+	return optionFunc(func(p *Problem) {
+		p.Instance = instance
+	})
+}
+
+// New returns the problem details of the validation errors, i.e. returned by valigo.Validator.ValidateTyped.
+// The title and the detail are translated with the validator translator in the languages of the ctx,
+// the request context of the handler wrapped with translator.NewAcceptLanguageMiddleware has the
+// languages of the Accept-Language header. The messages of the errors are translated during the validation,
+// so the ctx should be passed to the validation too.
+func New(ctx context.Context, v *valigo.Validator, errs []shared.Error, opts ...Option) *Problem {
+	t := v.GetTranslator()
+	p := &Problem{
+		Title:  t.T(ctx, titleLocaleKey),
+		Status: http.StatusUnprocessableEntity,
+		Detail: t.T(ctx, detailLocaleKey, len(errs)),
+		Errors: make([]Error, len(errs)),
+	}
+	for i, err := range errs {
+		p.Errors[i] = Error{
+			Location: err.Location,
+			Code:     err.Code,
+			Message:  err.Message,
+			Params:   err.Params,
+		}
+	}
+	for _, opt := range opts {
+		opt.apply(p)
+	}
+	return p
+}
+
+// Write writes the problem details of the validation errors to the w with the problem status code,
+// the problem is translated in the languages of the request context, see New.
+func Write(w http.ResponseWriter, r *http.Request, v *valigo.Validator, errs []shared.Error, opts ...Option) error {
+	p := New(r.Context(), v, errs, opts...)
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
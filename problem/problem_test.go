@@ -0,0 +1,63 @@
+package problem
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/insei/fmap/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insei/valigo"
+	"github.com/insei/valigo/translator"
+)
+
+type User struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func TestWrite(t *testing.T) {
+	v := valigo.New(valigo.WithFieldLocationNamingFn(func(field fmap.Field) string {
+		return field.GetTagPath("json", false)
+	}))
+	valigo.Configure[User](v, func(c valigo.Configurator[User], obj *User) {
+		c.String(&obj.Name).MaxLen(3)
+		c.Number(&obj.Age).Min(18)
+	})
+	handler := translator.NewAcceptLanguageMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		errs := v.ValidateTyped(r.Context(), &User{Name: "abcd", Age: 18})
+		assert.NoError(t, Write(w, r, v, errs, WithInstance(r.URL.Path)))
+	}))
+
+	r := httptest.NewRequest(http.MethodPost, "/users", nil)
+	r.Header.Set("Accept-Language", "ru")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, ContentType, w.Header().Get("Content-Type"))
+	expected := `{
+		"title": "Ошибка валидации",
+		"status": 422,
+		"detail": "Количество ошибок валидации: 1",
+		"instance": "/users",
+		"errors": [
+			{"location": "name", "code": "string.max_len", "message": "Не может быть длиннее 3 символов", "params": {"max": 3}}
+		]
+	}`
+	assert.JSONEq(t, expected, w.Body.String())
+}
+
+func TestNew(t *testing.T) {
+	v := valigo.New()
+	r := httptest.NewRequest(http.MethodPost, "/users", nil)
+	p := New(r.Context(), v, nil, WithType("https://example.com/problems/validation"), WithStatus(http.StatusBadRequest))
+	assert.Equal(t, &Problem{
+		Type:   "https://example.com/problems/validation",
+		Title:  "Validation failed",
+		Status: http.StatusBadRequest,
+		Detail: "Validation errors count: 0",
+		Errors: []Error{},
+	}, p)
+}
@@ -0,0 +1,34 @@
+package shared
+
+import "context"
+
+// asyncRunnerCtxKey is a context key of the AsyncRunner.
+type asyncRunnerCtxKey struct{}
+
+// AsyncRunner runs async custom rules concurrently.
+type AsyncRunner interface {
+	// Go runs fn asynchronously and returns the placeholder error,
+	// the placeholder is replaced with the fn results when the validation completes.
+	// The location of the placeholder is used as the prefix for the results locations.
+	Go(fn func() []Error) Error
+}
+
+// WithAsyncRunner returns a copy of the ctx with the AsyncRunner, nil runner disables async execution.
+func WithAsyncRunner(ctx context.Context, r AsyncRunner) context.Context {
+	return context.WithValue(ctx, asyncRunnerCtxKey{}, r)
+}
+
+// AsyncRunnerFromContext returns the AsyncRunner from the ctx, nil if there is no runner.
+func AsyncRunnerFromContext(ctx context.Context) AsyncRunner {
+	r, _ := ctx.Value(asyncRunnerCtxKey{}).(AsyncRunner)
+	return r
+}
+
+// WithoutAsyncRunner returns the ctx without the AsyncRunner,
+// it is used where the results of the rules are needed immediately.
+func WithoutAsyncRunner(ctx context.Context) context.Context {
+	if AsyncRunnerFromContext(ctx) == nil {
+		return ctx
+	}
+	return WithAsyncRunner(ctx, nil)
+}
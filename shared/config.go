@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/insei/fmap/v3"
+)
+
+// ErrInvalidConfiguration is the error of the invalid validation rules configuration,
+// all configuration errors wrap it.
+var ErrInvalidConfiguration = errors.New("invalid validation configuration")
+
+// ConfigErrorFn is a function type that reports the validation rules configuration error.
+type ConfigErrorFn func(err error)
+
+// Report reports the configuration error, it panics with the error if the function is nil.
+func (fn ConfigErrorFn) Report(err error) {
+	if fn == nil {
+		panic(err)
+	}
+	fn(err)
+}
+
+// NewConfigError returns the configuration error of the field, the field is optional,
+// i.e. "invalid validation configuration: field Age: number type is string".
+func NewConfigError(field fmap.Field, format string, args ...any) error {
+	if field == nil {
+		return fmt.Errorf("%w: %s", ErrInvalidConfiguration, fmt.Sprintf(format, args...))
+	}
+	return fmt.Errorf("%w: field %s: %s", ErrInvalidConfiguration, field.GetStructPath(), fmt.Sprintf(format, args...))
+}
+
+// DiscardFieldValidationFn is an append function that discards the field validation function,
+// it is used by the configurators of the invalid configuration.
+func DiscardFieldValidationFn(FieldValidationFn) {}
+
+// DiscardConfigError is a configuration error reporting function that discards the error,
+// it is used by the configurators of the invalid configuration to not report the errors twice.
+func DiscardConfigError(error) {}
@@ -0,0 +1,62 @@
+package shared
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/insei/fmap/v3"
+)
+
+func TestNewConfigError(t *testing.T) {
+	type TestStruct struct {
+		Age int
+	}
+	fields, err := fmap.Get[TestStruct]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		field    fmap.Field
+		expected string
+	}{
+		{
+			name:     "With field",
+			field:    fields.MustFind("Age"),
+			expected: "invalid validation configuration: field Age: type int is not a string",
+		},
+		{
+			name:     "Without field",
+			expected: "invalid validation configuration: type int is not a string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewConfigError(tt.field, "type %s is not a string", "int")
+			if err.Error() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, err.Error())
+			}
+			if !errors.Is(err, ErrInvalidConfiguration) {
+				t.Errorf("expected error wrapping ErrInvalidConfiguration, got %v", err)
+			}
+		})
+	}
+}
+
+func TestConfigErrorFnReport(t *testing.T) {
+	var reported error
+	ConfigErrorFn(func(err error) {
+		reported = err
+	}).Report(ErrInvalidConfiguration)
+	if reported != ErrInvalidConfiguration {
+		t.Errorf("expected reported error, got %v", reported)
+	}
+
+	defer func() {
+		if r := recover(); r != ErrInvalidConfiguration {
+			t.Errorf("expected panic with the error, got %v", r)
+		}
+	}()
+	var fn ConfigErrorFn
+	fn.Report(ErrInvalidConfiguration)
+}
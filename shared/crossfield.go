@@ -0,0 +1,43 @@
+package shared
+
+import (
+	"reflect"
+	"unsafe"
+
+	"github.com/insei/fmap/v3"
+)
+
+// CrossField represents the other field of the same struct used by the cross-field rules.
+type CrossField struct {
+	// Field is the other field.
+	Field fmap.Field
+	// delta is the offset of the other field relative to the validated field.
+	delta int
+}
+
+// NewCrossField returns the CrossField for the other field of the obj by the pointer to the other field.
+// The other field should have the same dereferenced type as the validated field.
+// The fields storage is nil for the container elements (i.e. map values), such elements are not supported.
+func NewCrossField(fields fmap.Storage, obj any, field fmap.Field, otherFieldPtr any) (CrossField, error) {
+	if fields == nil {
+		return CrossField{}, NewConfigError(field, "cross-field rules are not supported for elements")
+	}
+	other, err := fields.GetFieldByPtr(obj, otherFieldPtr)
+	if err != nil {
+		return CrossField{}, NewConfigError(field, "cross-field rule other field: %v", err)
+	}
+	if other.GetDereferencedType() != field.GetDereferencedType() {
+		return CrossField{}, NewConfigError(field, "dereferenced type is %s, but field %s dereferenced type is %s",
+			field.GetDereferencedType().String(), other.GetStructPath(), other.GetDereferencedType().String())
+	}
+	return CrossField{
+		Field: other,
+		delta: int(other.GetOffset()) - int(field.GetOffset()),
+	}, nil
+}
+
+// Ptr returns the pointer to the other field value by the pointer to the validated field value.
+func (c CrossField) Ptr(fieldPtr any) any {
+	ptr := unsafe.Add(reflect.ValueOf(fieldPtr).UnsafePointer(), c.delta)
+	return reflect.NewAt(c.Field.GetType(), ptr).Interface()
+}
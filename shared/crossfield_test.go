@@ -0,0 +1,50 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/insei/fmap/v3"
+)
+
+type priceRange struct {
+	Name     string
+	MinPrice int
+	MaxPrice *int
+	Label    string
+}
+
+func TestNewCrossField(t *testing.T) {
+	obj := &priceRange{MinPrice: 10, Label: "label"}
+	strg, _ := fmap.GetFrom(obj)
+	field, _ := strg.GetFieldByPtr(obj, &obj.Name)
+
+	cf, err := NewCrossField(strg, obj, field, &obj.Label)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cf.Field.GetStructPath() != "Label" {
+		t.Errorf("expected Label field, got %s", cf.Field.GetStructPath())
+	}
+	if ptr := cf.Ptr(&obj.Name); ptr != &obj.Label {
+		t.Errorf("expected pointer to Label field, got %v", ptr)
+	}
+
+	minField, _ := strg.GetFieldByPtr(obj, &obj.MinPrice)
+	cf, err = NewCrossField(strg, obj, minField, &obj.MaxPrice)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ptr := cf.Ptr(&obj.MinPrice); ptr != &obj.MaxPrice {
+		t.Errorf("expected pointer to MaxPrice field, got %v", ptr)
+	}
+
+	if _, err = NewCrossField(strg, obj, field, &obj.MinPrice); err == nil {
+		t.Errorf("expected types mismatch error")
+	}
+	if _, err = NewCrossField(nil, obj, field, &obj.Label); err == nil {
+		t.Errorf("expected elements error")
+	}
+	if _, err = NewCrossField(strg, obj, field, new(string)); err == nil {
+		t.Errorf("expected field not found error")
+	}
+}
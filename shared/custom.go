@@ -0,0 +1,85 @@
+package shared
+
+import (
+	"context"
+	"time"
+)
+
+// CustomOptions is a struct that represents the options of the custom validation rule.
+type CustomOptions struct {
+	// DependsOn is a list of the fields the struct level custom rule depends on,
+	// the fields are specified by pointers to fields or struct paths (i.e. "Address.City").
+	DependsOn []any
+	// Timeout is a timeout of the custom rule, zero value means no timeout.
+	Timeout time.Duration
+	// Async marks the custom rule as async, such rules run concurrently if the AsyncRunner is set to the context.
+	Async bool
+}
+
+// CustomOption is a function that applies an option to the custom validation rule.
+type CustomOption func(o *CustomOptions)
+
+// WithDependsOn returns a CustomOption that declares the fields the struct level custom rule depends on.
+// The partial validation runs the rule only when at least one of the fields is selected.
+func WithDependsOn(fields ...any) CustomOption {
+	return func(o *CustomOptions) {
+		o.DependsOn = append(o.DependsOn, fields...)
+	}
+}
+
+// WithTimeout returns a CustomOption that sets the timeout of the custom rule.
+// The custom function is called with a context that is done when the timeout expires,
+// the function is responsible for honoring the context.
+func WithTimeout(timeout time.Duration) CustomOption {
+	return func(o *CustomOptions) {
+		o.Timeout = timeout
+	}
+}
+
+// WithAsync returns a CustomOption that marks the custom rule as async.
+// Async rules run concurrently with other rules when the validation context has the AsyncRunner,
+// otherwise they run sequentially. Async rules should not modify the validated object
+// and should be declared after the custom rules that modify the validated fields,
+// the normalization rules (i.e. Trim) are applied before all rules.
+func WithAsync() CustomOption {
+	return func(o *CustomOptions) {
+		o.Async = true
+	}
+}
+
+// NewCustomOptions creates a new CustomOptions instance with the options applied.
+func NewCustomOptions(opts ...CustomOption) CustomOptions {
+	o := CustomOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// Wrap returns the validation function with the options applied.
+func (o CustomOptions) Wrap(fn FieldValidationFn) FieldValidationFn {
+	if o.Timeout > 0 {
+		timeout := o.Timeout
+		timeoutFn := fn
+		fn = func(ctx context.Context, h Helper, v any) []Error {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return timeoutFn(ctx, h, v)
+		}
+	}
+	if o.Async {
+		asyncFn := fn
+		fn = func(ctx context.Context, h Helper, v any) []Error {
+			r := AsyncRunnerFromContext(ctx)
+			if r == nil {
+				return asyncFn(ctx, h, v)
+			}
+			return []Error{r.Go(func() []Error {
+				return asyncFn(ctx, h, v)
+			})}
+		}
+	}
+	return fn
+}
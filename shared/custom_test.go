@@ -0,0 +1,33 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNewCustomOptions(t *testing.T) {
+	field := new(string)
+	o := NewCustomOptions(WithDependsOn(field, "Name"), WithTimeout(time.Second), nil)
+	if len(o.DependsOn) != 2 || o.DependsOn[0] != field || o.DependsOn[1] != "Name" {
+		t.Errorf("unexpected depends on: %v", o.DependsOn)
+	}
+	if o.Timeout != time.Second {
+		t.Errorf("expected timeout %v, got %v", time.Second, o.Timeout)
+	}
+}
+
+func TestCustomOptionsWrap(t *testing.T) {
+	fn := func(ctx context.Context, h Helper, v any) []Error {
+		if _, ok := ctx.Deadline(); ok {
+			return []Error{{Message: "deadline"}}
+		}
+		return nil
+	}
+	if errs := NewCustomOptions().Wrap(fn)(context.Background(), nil, nil); len(errs) != 0 {
+		t.Errorf("expected no deadline, got %v", errs)
+	}
+	if errs := NewCustomOptions(WithTimeout(time.Second)).Wrap(fn)(context.Background(), nil, nil); len(errs) != 1 {
+		t.Errorf("expected deadline, got %v", errs)
+	}
+}
@@ -1,6 +1,29 @@
 package shared
 
-import "fmt"
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrValidationInterrupted is the error of the validation interrupted by the context cancellation or deadline,
+// the Error.Err of such error wraps both ErrValidationInterrupted and the context error.
+var ErrValidationInterrupted = errors.New("validation interrupted")
+
+// CodeError is the sentinel error of the error code, errors.Is reports whether the Error has the code,
+// i.e. errors.Is(err, str.ErrMaxLen) or errors.Is(err, shared.CodeError("user.name_taken")).
+type CodeError string
+
+// NewCodeError returns the sentinel error of the code of the rule with the name for the values of the kind.
+func NewCodeError(kind, name string) CodeError {
+	return CodeError(RuleCode(kind, name))
+}
+
+// Error implements the error interface, it returns the code.
+func (e CodeError) Error() string {
+	return string(e)
+}
 
 // Error define a custom error struct.
 type Error struct {
@@ -10,6 +33,15 @@ type Error struct {
 	Location string
 	// Additional error information (e.g., a value that caused the error).
 	Value any
+	// The stable machine-readable code of the failed rule, i.e. "string.max_len", see RuleCode.
+	// It is set by the built-in rules and can be set by the custom rules with WithCode.
+	Code string `json:",omitempty"`
+	// The parameters of the failed rule by names, i.e. {"max": 64}, nil for the rules without parameters.
+	Params *Params `json:",omitempty"`
+	// The untranslated locale key (format) of the message.
+	LocaleKey string `json:",omitempty"`
+	// The underlying error, if any (e.g., the context error of the interrupted validation).
+	Err error `json:"-"`
 }
 
 // Error implements the error interface by defining an Error() method.
@@ -19,3 +51,98 @@ func (e Error) Error() string {
 	}
 	return fmt.Sprintf("%s (%s: %v)", e.Message, e.Location, e.Value)
 }
+
+// WithCode returns the copy of the error with the code and the params, i.e. for the errors of the custom rules:
+// h.ErrorT(ctx, value, localeKey).WithCode("user.name_taken", shared.Param{Name: "name", Value: name}).
+func (e Error) WithCode(code string, params ...Param) Error {
+	e.Code, e.Params = code, NewParams(params...)
+	return e
+}
+
+// Is reports whether the target is the CodeError of the error code.
+func (e Error) Is(target error) bool {
+	code, ok := target.(CodeError)
+	return ok && e.Code != "" && e.Code == string(code)
+}
+
+// Unwrap returns the underlying error.
+func (e Error) Unwrap() error {
+	return e.Err
+}
+
+// Param is the named parameter of the failed rule.
+type Param struct {
+	Name  string
+	Value any
+}
+
+// Params is the immutable ordered list of the named parameters of the failed rule.
+// The errors refer to the params by the pointer, so Error stays comparable,
+// the errors of the same rule share the same params.
+type Params struct {
+	list []Param
+}
+
+// NewParams returns the params of the list, it returns nil for the empty list.
+func NewParams(params ...Param) *Params {
+	if len(params) == 0 {
+		return nil
+	}
+	return &Params{list: append([]Param(nil), params...)}
+}
+
+// Len returns the number of the params, the nil params are empty.
+func (p *Params) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(p.list)
+}
+
+// Get returns the value of the param by the name, it returns false if there is no such param.
+func (p *Params) Get(name string) (any, bool) {
+	if p == nil {
+		return nil, false
+	}
+	for _, param := range p.list {
+		if param.Name == name {
+			return param.Value, true
+		}
+	}
+	return nil, false
+}
+
+// All returns the copy of the params list in the order of the rule parameters.
+func (p *Params) All() []Param {
+	if p == nil {
+		return nil
+	}
+	return append([]Param(nil), p.list...)
+}
+
+// MarshalJSON implements the json.Marshaler interface, the params are the JSON object in the params order.
+func (p *Params) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return []byte("null"), nil
+	}
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, param := range p.list {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		name, err := json.Marshal(param.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(param.Value)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(name)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
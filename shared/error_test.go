@@ -1,6 +1,10 @@
 package shared
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -58,3 +62,76 @@ func TestError(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorUnwrap(t *testing.T) {
+	err := Error{Message: "interrupted", Err: fmt.Errorf("%w: %w", ErrValidationInterrupted, context.Canceled)}
+	if !errors.Is(err, ErrValidationInterrupted) || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected error to wrap interruption and context errors")
+	}
+	if (Error{Message: "test"}).Unwrap() != nil {
+		t.Errorf("expected nil underlying error")
+	}
+}
+
+func TestErrorWithCode(t *testing.T) {
+	err := Error{Message: "test"}.WithCode("user.taken", Param{Name: "name", Value: "a"})
+	if name, _ := err.Params.Get("name"); err.Code != "user.taken" || name != "a" || err.Message != "test" {
+		t.Errorf("unexpected error %+v", err)
+	}
+	if err := (Error{Message: "test"}).WithCode("user.taken"); err.Params != nil {
+		t.Errorf("expected nil params, got %v", err.Params)
+	}
+}
+
+func TestErrorComparable(t *testing.T) {
+	params := (Rule{Name: RuleMaxLen, Params: []any{64}}).ErrorParams()
+	err := Error{Message: "test", Code: "string.max_len", Params: params}
+	if err != (Error{Message: "test", Code: "string.max_len", Params: params}) {
+		t.Errorf("expected errors of the same rule to be equal")
+	}
+}
+
+func TestParamsMarshalJSON(t *testing.T) {
+	params := NewParams(Param{Name: "end", Value: 120}, Param{Name: "begin", Value: 18})
+	data, err := json.Marshal(Error{Params: params})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"Message":"","Location":"","Value":null,"Params":{"end":120,"begin":18}}`; string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestRuleCode(t *testing.T) {
+	tests := map[string]string{
+		RuleCode(KindString, RuleMaxLen):   "string.max_len",
+		RuleCode(KindNumber, RuleGteField): "number.gte_field",
+		RuleCode(KindMap, RuleMinEntries):  "map.min_entries",
+		RuleCode("", RuleAnyOfInterval):    "any_of_interval",
+		RuleCode(KindUUID, RuleRequired):   "uuid.required",
+	}
+	for actual, expected := range tests {
+		if actual != expected {
+			t.Errorf("expected %s, got %s", expected, actual)
+		}
+	}
+}
+
+func TestRuleErrorParams(t *testing.T) {
+	if params := (Rule{Name: RuleMaxLen, Params: []any{64}}).ErrorParams(); params.Len() != 1 || params.All()[0] != (Param{Name: "max", Value: 64}) {
+		t.Errorf("unexpected params %v", params)
+	}
+	if params := (Rule{Name: RuleRequired}).ErrorParams(); params != nil {
+		t.Errorf("expected nil params, got %v", params)
+	}
+}
+
+func TestErrorIsCode(t *testing.T) {
+	err := Error{Message: "test", Code: "map.min_entries"}
+	if !errors.Is(err, ErrMapMinEntries) || errors.Is(err, ErrMapMaxEntries) {
+		t.Errorf("expected error to match the code sentinel only")
+	}
+	if errors.Is(Error{Message: "test"}, CodeError("")) {
+		t.Errorf("expected error without code not to match")
+	}
+}
@@ -2,12 +2,17 @@ package shared
 
 import (
 	"context"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
 
 	"github.com/insei/fmap/v3"
 )
 
 type ValidationFnMaker[T any] interface {
 	Make(validationFn func(v T) bool, format string, args ...any) FieldValidationFn
+	MakeCrossField(getOther func(value any) (T, bool), validationFn func(v, other T) bool, format string, args ...any) FieldValidationFn
 	CustomMake(func(ctx context.Context, h Helper, value any) []Error) FieldValidationFn
 }
 
@@ -55,17 +60,170 @@ func (i *simpleFieldFnMaker[T]) Make(validationFn func(v T) bool, format string,
 	}
 }
 
+// MakeCrossField makes the validation function comparing the field value with the other field value,
+// getOther reads the other field value by the pointer to the field value.
+// The rule is skipped if the other field value cannot be read, i.e. it is nil.
+func (i *simpleFieldFnMaker[T]) MakeCrossField(getOther func(value any) (T, bool), validationFn func(v, other T) bool, format string, args ...any) FieldValidationFn {
+	return func(ctx context.Context, h Helper, val any) []Error {
+		v, isValid := i.getValue(val)
+		if !isValid {
+			return []Error{i.helper.ErrorT(ctx, i.field, val, format, args...)}
+		}
+		other, ok := getOther(val)
+		if !ok {
+			return nil
+		}
+		if !validationFn(v, other) {
+			return []Error{h.ErrorT(ctx, i.field, v, format, args...)}
+		}
+		return nil
+	}
+}
+
 type FieldConfigurator[T any] struct {
-	appendFn func(fn FieldValidationFn)
-	mk       ValidationFnMaker[T]
+	appendFn   func(fn FieldValidationFn)
+	describeFn DescribeFn
+	mk         ValidationFnMaker[T]
+	kind       string
+	itemsKind  string
 }
 
 func (i *FieldConfigurator[T]) Append(validationFn func(v T) bool, format string, args ...any) {
 	i.appendFn(i.mk.Make(validationFn, format, args...))
 }
 
-func (i *FieldConfigurator[T]) CustomAppend(fn FieldValidationFn) {
-	i.appendFn(fn)
+// AppendRule is similar to Append, but the rule is described with the descriptor r
+// and the error message format and args, the errors of the rule have the rule code and params.
+func (i *FieldConfigurator[T]) AppendRule(r Rule, validationFn func(v T) bool, format string, args ...any) {
+	r.LocaleKey, r.LocaleArgs = format, args
+	i.describeFn.Describe(r)
+	i.appendFn(withErrorCode(i.mk.Make(validationFn, format, args...), i.code(r), r.ErrorParams()))
+}
+
+// code returns the error code of the rule r, the codes of the slice elements rules have the elements kind.
+func (i *FieldConfigurator[T]) code(r Rule) string {
+	if r.Scope == RuleScopeItems && i.itemsKind != "" {
+		return RuleCode(i.itemsKind, r.Name)
+	}
+	return RuleCode(i.kind, r.Name)
+}
+
+// withErrorCode returns the validation function setting the code and the params to the errors of the fn,
+// the errors with the code or the underlying error are not modified.
+func withErrorCode(fn FieldValidationFn, code string, params *Params) FieldValidationFn {
+	return func(ctx context.Context, h Helper, v any) []Error {
+		errs := fn(ctx, h, v)
+		for k := range errs {
+			if errs[k].Code == "" && errs[k].Err == nil {
+				errs[k].Code, errs[k].Params = code, params
+			}
+		}
+		return errs
+	}
+}
+
+// AppendNormalizer appends the normalization rule described with the descriptor r, the transformFn modifies
+// the value in the normalization phase before the validation rules. Without the rules descriptors receiver
+// the transformation is appended as a validation rule and applied in the rules order.
+func (i *FieldConfigurator[T]) AppendNormalizer(r Rule, transformFn func(v T)) {
+	fn := i.mk.Make(func(v T) bool {
+		transformFn(v)
+		return true
+	}, "")
+	if i.describeFn == nil {
+		i.appendFn(fn)
+		return
+	}
+	r.Normalize = func(ctx context.Context, v any) {
+		fn(ctx, nil, v)
+	}
+	i.describeFn.Describe(r)
+}
+
+// AppendDefault appends the normalization rule described with the descriptor r, it sets the value returned
+// by the defaultFn to the empty field in the normalization phase, so the following required checks pass.
+// The field is empty if it is a nil pointer or the zero value, the nil pointers are allocated.
+// The defaultFn returns false if the default value is unavailable, the field is left empty.
+func (i *FieldConfigurator[T]) AppendDefault(r Rule, defaultFn func() (any, bool)) {
+	normalize := func(_ context.Context, v any) {
+		setDefault(v, defaultFn)
+	}
+	if i.describeFn == nil {
+		i.appendFn(func(ctx context.Context, _ Helper, v any) []Error {
+			normalize(ctx, v)
+			return nil
+		})
+		return
+	}
+	r.Normalize = normalize
+	i.describeFn.Describe(r)
+}
+
+// setDefault sets the value returned by the defaultFn to the empty value of the pointer ptr,
+// the nil pointers of the pointer chain are allocated. The value is converted to the field type.
+func setDefault(ptr any, defaultFn func() (any, bool)) {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return
+	}
+	rv = rv.Elem()
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Ptr && !rv.IsZero() {
+		return
+	}
+	value, ok := defaultFn()
+	if !ok {
+		return
+	}
+	dv := reflect.ValueOf(value)
+	t := rv.Type()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if !dv.IsValid() || !dv.Type().ConvertibleTo(t) {
+		return
+	}
+	for rv.Kind() == reflect.Ptr {
+		rv.Set(reflect.New(rv.Type().Elem()))
+		rv = rv.Elem()
+	}
+	rv.Set(dv.Convert(t))
+}
+
+// AppendCrossField appends the rule comparing the field value with the other field value.
+func (i *FieldConfigurator[T]) AppendCrossField(getOther func(value any) (T, bool), validationFn func(v, other T) bool, format string, args ...any) {
+	i.appendFn(i.mk.MakeCrossField(getOther, validationFn, format, args...))
+}
+
+// AppendCrossFieldRule is similar to AppendCrossField, but the rule is described with the descriptor r
+// and the error message format and args, the first of args is the other field location.
+// The errors of the rule have the rule code and the other field location as the "field" param.
+func (i *FieldConfigurator[T]) AppendCrossFieldRule(r Rule, getOther func(value any) (T, bool), validationFn func(v, other T) bool, format string, args ...any) {
+	r.LocaleKey, r.LocaleArgs = format, args
+	i.describeFn.Describe(r)
+	var params *Params
+	if len(args) > 0 {
+		params = NewParams(Param{Name: "field", Value: args[0]})
+	}
+	fn := i.mk.MakeCrossField(getOther, validationFn, format, args...)
+	i.appendFn(withErrorCode(fn, i.code(r), params))
+}
+
+func (i *FieldConfigurator[T]) CustomAppend(fn FieldValidationFn, opts ...CustomOption) {
+	i.CustomAppendRule(Rule{Name: RuleCustom}, fn, opts...)
+}
+
+// CustomAppendRule appends the rule implemented with the custom validation logic,
+// the rule is described with the descriptor r instead of the custom rule descriptor.
+// The errors of the built-in rules without the code have the rule code and params.
+func (i *FieldConfigurator[T]) CustomAppendRule(r Rule, fn FieldValidationFn, opts ...CustomOption) {
+	i.describeFn.Describe(r)
+	if r.Name != RuleCustom {
+		fn = withErrorCode(fn, i.code(r), r.ErrorParams())
+	}
+	i.appendFn(NewCustomOptions(opts...).Wrap(fn))
 }
 
 func (i *FieldConfigurator[T]) NewWithWhen(whenFn func(ctx context.Context, value any) bool) *FieldConfigurator[T] {
@@ -79,18 +237,77 @@ func (i *FieldConfigurator[T]) NewWithWhen(whenFn func(ctx context.Context, valu
 			}
 			i.appendFn(fnWithEnabler)
 		},
-		mk: i.mk,
+		describeFn: i.describeFn.conditional(whenFn),
+		mk:         i.mk,
+		kind:       i.kind,
+		itemsKind:  i.itemsKind,
+	}
+}
+
+// Bail returns a new FieldConfigurator, the rules appended to it are evaluated in order
+// and the evaluation stops at the first failed rule.
+func (i *FieldConfigurator[T]) Bail() *FieldConfigurator[T] {
+	fns := &FieldValidationFns{}
+	i.appendFn(func(ctx context.Context, h Helper, v any) []Error {
+		// results of the async rules are needed to stop the evaluation
+		ctx = WithoutAsyncRunner(ctx)
+		for _, fn := range fns.Load() {
+			if errs := fn(ctx, h, v); len(errs) > 0 {
+				return errs
+			}
+		}
+		return nil
+	})
+	return &FieldConfigurator[T]{
+		appendFn:   fns.Append,
+		describeFn: i.describeFn,
+		mk:         i.mk,
+		kind:       i.kind,
+		itemsKind:  i.itemsKind,
+	}
+}
+
+// FieldValidationFns is a list of the validation functions run by the validation function registered before
+// the list is filled, i.e. the rules of the Bail chain or the rules of the slice elements.
+// The list is copy-on-write, so it can be appended while the registered function is running.
+type FieldValidationFns struct {
+	mu  sync.Mutex
+	fns atomic.Pointer[[]FieldValidationFn]
+}
+
+// Append appends the validation function to the list.
+func (l *FieldValidationFns) Append(fn FieldValidationFn) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fns := append(slices.Clip(l.Load()), fn)
+	l.fns.Store(&fns)
+}
+
+// Load returns the validation functions of the list, the returned slice must not be modified.
+func (l *FieldValidationFns) Load() []FieldValidationFn {
+	if fns := l.fns.Load(); fns != nil {
+		return *fns
 	}
+	return nil
 }
 
 type FieldConfiguratorParams[T any] struct {
 	Maker    ValidationFnMaker[T]
 	AppendFn func(fn FieldValidationFn)
+	// DescribeFn receives the descriptors of the appended rules, it is optional.
+	DescribeFn DescribeFn
+	// Kind is the kind of the field values, i.e. KindString, it prefixes the error codes of the rules.
+	Kind string
+	// ItemsKind is the kind of the slice elements, it prefixes the error codes of the elements rules.
+	ItemsKind string
 }
 
 func NewFieldConfigurator[T any](p FieldConfiguratorParams[T]) *FieldConfigurator[T] {
 	return &FieldConfigurator[T]{
-		appendFn: p.AppendFn,
-		mk:       p.Maker,
+		appendFn:   p.AppendFn,
+		describeFn: p.DescribeFn,
+		mk:         p.Maker,
+		kind:       p.Kind,
+		itemsKind:  p.ItemsKind,
 	}
 }
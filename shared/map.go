@@ -0,0 +1,144 @@
+package shared
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/insei/fmap/v3"
+)
+
+const (
+	mapMinEntriesLocaleKey = "validation:map:Cannot contain less than %d entries"
+	mapMaxEntriesLocaleKey = "validation:map:Cannot contain more than %d entries"
+	mapRequiredLocaleKey   = "validation:map:Should be fulfilled"
+)
+
+// Sentinel errors of the map rules, errors.Is reports whether the validation error is the error of the rule.
+var (
+	ErrMapRequired   = NewCodeError(KindMap, RuleRequired)
+	ErrMapMinEntries = NewCodeError(KindMap, RuleMinEntries)
+	ErrMapMaxEntries = NewCodeError(KindMap, RuleMaxEntries)
+)
+
+// MapFieldConfigurator is a configurator for map fields.
+// It provides methods for adding validation rules to the map as a whole.
+type MapFieldConfigurator struct {
+	field  fmap.Field
+	helper Helper
+	c      *FieldConfigurator[reflect.Value]
+}
+
+// getMapValue dereferences a pointer to the map field value (*map, **map) to the map reflect.Value.
+func getMapValue(value any) (reflect.Value, bool) {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return reflect.Value{}, false
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Map {
+		return reflect.Value{}, false
+	}
+	return rv, true
+}
+
+// MapFieldConfiguratorParams is a struct that represents the parameters for the map field configurator.
+type MapFieldConfiguratorParams struct {
+	Field    fmap.Field
+	Helper   Helper
+	AppendFn func(fn FieldValidationFn)
+	// ErrorFn reports the configuration errors, the configuration errors panic if it is nil.
+	ErrorFn ConfigErrorFn
+	// DescribeFn receives the descriptors of the appended rules, it is optional.
+	DescribeFn DescribeFn
+}
+
+// NewMapFieldConfigurator creates a new MapFieldConfigurator instance.
+func NewMapFieldConfigurator(p MapFieldConfiguratorParams) *MapFieldConfigurator {
+	switch {
+	case p.Field == nil:
+		p.ErrorFn.Report(NewConfigError(nil, "map field is not found"))
+		// the rules of the invalid field are discarded
+		p.AppendFn, p.DescribeFn = DiscardFieldValidationFn, nil
+	case p.Field.GetDereferencedType().Kind() != reflect.Map:
+		p.ErrorFn.Report(NewConfigError(p.Field, "type %s is not a map", p.Field.GetType().String()))
+		p.AppendFn, p.DescribeFn = DiscardFieldValidationFn, nil
+	}
+	mk := NewSimpleFieldFnMaker(SimpleFieldFnMakerParams[reflect.Value]{
+		GetValue: getMapValue,
+		Field:    p.Field,
+		Helper:   p.Helper,
+	})
+	return &MapFieldConfigurator{
+		field:  p.Field,
+		helper: p.Helper,
+		c: NewFieldConfigurator(FieldConfiguratorParams[reflect.Value]{
+			Maker:      mk,
+			AppendFn:   p.AppendFn,
+			DescribeFn: p.DescribeFn,
+			Kind:       KindMap,
+		}),
+	}
+}
+
+// MinEntries checks if the map contains at least minEntries entries.
+func (m *MapFieldConfigurator) MinEntries(minEntries int) *MapFieldConfigurator {
+	m.c.AppendRule(Rule{Name: RuleMinEntries, Params: []any{minEntries}}, func(v reflect.Value) bool {
+		return v.Len() >= minEntries
+	}, mapMinEntriesLocaleKey, minEntries)
+	return m
+}
+
+// MaxEntries checks if the map contains no more than maxEntries entries.
+func (m *MapFieldConfigurator) MaxEntries(maxEntries int) *MapFieldConfigurator {
+	m.c.AppendRule(Rule{Name: RuleMaxEntries, Params: []any{maxEntries}}, func(v reflect.Value) bool {
+		return v.Len() <= maxEntries
+	}, mapMaxEntriesLocaleKey, maxEntries)
+	return m
+}
+
+// Required checks if the map is not empty.
+func (m *MapFieldConfigurator) Required() *MapFieldConfigurator {
+	m.c.AppendRule(Rule{Name: RuleRequired}, func(v reflect.Value) bool {
+		return v.Len() > 0
+	}, mapRequiredLocaleKey)
+	return m
+}
+
+// Custom allows for custom validation logic, the value is the map itself.
+func (m *MapFieldConfigurator) Custom(f func(ctx context.Context, h *FieldCustomHelper, value any) []Error, opts ...CustomOption) *MapFieldConfigurator {
+	customHelper := NewFieldCustomHelper(m.field, m.helper)
+	m.c.CustomAppend(m.c.mk.CustomMake(func(ctx context.Context, h Helper, value any) []Error {
+		return f(ctx, customHelper, value.(reflect.Value).Interface())
+	}), opts...)
+	return m
+}
+
+// EntriesRules returns the append function and the descriptors receiver of the map entries rules,
+// i.e. the rules of the keys and values, they are registered with the rules chain of the configurator,
+// so the When condition and Bail apply to them.
+func (m *MapFieldConfigurator) EntriesRules() (appendFn func(fn FieldValidationFn), describeFn DescribeFn) {
+	return m.c.appendFn, m.c.describeFn
+}
+
+// When allows for conditional validation based on a given condition.
+func (m *MapFieldConfigurator) When(whenFn func(ctx context.Context, value any) bool) *MapFieldConfigurator {
+	if whenFn == nil {
+		return m
+	}
+	return &MapFieldConfigurator{
+		field:  m.field,
+		helper: m.helper,
+		c:      m.c.NewWithWhen(whenFn),
+	}
+}
+
+// Bail stops the evaluation of the following rules at the first failed rule.
+func (m *MapFieldConfigurator) Bail() *MapFieldConfigurator {
+	return &MapFieldConfigurator{
+		field:  m.field,
+		helper: m.helper,
+		c:      m.c.Bail(),
+	}
+}
@@ -0,0 +1,197 @@
+package shared
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/insei/fmap/v3"
+)
+
+// Names of the rules described by the configurators.
+const (
+	RuleRequired      = "required"
+	RuleMinLen        = "minLen"
+	RuleMaxLen        = "maxLen"
+	RuleMinRunes      = "minRunes"
+	RuleMaxRunes      = "maxRunes"
+	RuleRegexp        = "regexp"
+	RuleAnyOf         = "anyOf"
+	RuleEmail         = "email"
+	RuleMin           = "min"
+	RuleMax           = "max"
+	RuleGt            = "gt"
+	RuleLt            = "lt"
+	RuleAnyOfInterval = "anyOfInterval"
+	RuleMinEntries    = "minEntries"
+	RuleMaxEntries    = "maxEntries"
+	RuleUnique        = "unique"
+	RuleEqField       = "eqField"
+	RuleNeField       = "neField"
+	RuleGtField       = "gtField"
+	RuleGteField      = "gteField"
+	RuleLtField       = "ltField"
+	RuleLteField      = "lteField"
+	RuleCustom        = "custom"
+	RuleTrim          = "trim"
+	RuleDefault       = "default"
+)
+
+// Kinds of the validated values, the error codes of the rules are prefixed with the kind, see RuleCode.
+const (
+	KindString = "string"
+	KindNumber = "number"
+	KindUUID   = "uuid"
+	KindSlice  = "slice"
+	KindMap    = "map"
+	KindStruct = "struct"
+)
+
+// RuleCode returns the error code of the rule with the name for the values of the kind,
+// the rule name is converted to the snake case, i.e. RuleCode(KindString, RuleMaxLen) is "string.max_len".
+func RuleCode(kind, name string) string {
+	var b strings.Builder
+	if kind != "" {
+		b.WriteString(kind)
+		b.WriteByte('.')
+	}
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// ruleParamsNames are the names of the rules parameters in the error params.
+var ruleParamsNames = map[string][]string{
+	RuleMinLen:        {"min"},
+	RuleMaxLen:        {"max"},
+	RuleMinRunes:      {"min"},
+	RuleMaxRunes:      {"max"},
+	RuleMin:           {"min"},
+	RuleMax:           {"max"},
+	RuleGt:            {"gt"},
+	RuleLt:            {"lt"},
+	RuleAnyOfInterval: {"begin", "end"},
+	RuleMinEntries:    {"min"},
+	RuleMaxEntries:    {"max"},
+	RuleRegexp:        {"pattern"},
+}
+
+// ErrorParams returns the parameters of the rule by names for the errors of the rule,
+// the allowed values of the AnyOf rule are the "allowed" parameter. It returns nil for the rules without parameters.
+func (r Rule) ErrorParams() *Params {
+	if r.Name == RuleAnyOf && len(r.Params) > 0 {
+		return NewParams(Param{Name: "allowed", Value: r.Params})
+	}
+	names := ruleParamsNames[r.Name]
+	if len(names) == 0 || len(names) != len(r.Params) {
+		return nil
+	}
+	params := make([]Param, len(names))
+	for i, name := range names {
+		params[i] = Param{Name: name, Value: r.Params[i]}
+	}
+	return NewParams(params...)
+}
+
+// RuleScope is the part of the field value the rule is applied to.
+type RuleScope string
+
+const (
+	// RuleScopeValue is the scope of the rules applied to the field value itself.
+	RuleScopeValue RuleScope = ""
+	// RuleScopeItems is the scope of the rules applied to each slice element.
+	RuleScopeItems RuleScope = "items"
+	// RuleScopeKeys is the scope of the rules applied to each map key.
+	RuleScopeKeys RuleScope = "keys"
+	// RuleScopeValues is the scope of the rules applied to each map value.
+	RuleScopeValues RuleScope = "values"
+)
+
+// Rule is a descriptor of the configured validation rule, the validation functions are opaque closures,
+// so the descriptors are used to publish the rules, i.e. as JSON Schema.
+type Rule struct {
+	// Name is the name of the rule, i.e. RuleMaxLen.
+	Name string
+	// Params are the parameters of the rule, i.e. the max length,
+	// the struct path of the other field for the cross-field rules.
+	Params []any
+	// Scope is the part of the field value the rule is applied to.
+	Scope RuleScope
+	// Conditional is true for the rules enabled with the field When condition.
+	Conditional bool
+	// LocaleKey is the locale key (format) of the rule error message, it is empty for the custom rules.
+	LocaleKey string
+	// LocaleArgs are the arguments of the rule error message.
+	LocaleArgs []any
+	// Normalize is the value transformation of the normalization rules, i.e. Trim,
+	// it is applied in the normalization phase before the validation rules.
+	Normalize FieldNormalizationFn
+}
+
+// RuleParams converts the values to the rule descriptor parameters, i.e. the allowed values of the AnyOf rule.
+func RuleParams[T any](values []T) []any {
+	params := make([]any, len(values))
+	for i, v := range values {
+		params[i] = v
+	}
+	return params
+}
+
+// DescribeFn is a function type that receives the descriptors of the configured rules.
+type DescribeFn func(r Rule)
+
+// Describe passes the rule descriptor to the function, it does nothing if the function is nil
+// or the rule name is empty.
+func (fn DescribeFn) Describe(r Rule) {
+	if fn != nil && r.Name != "" {
+		fn(r)
+	}
+}
+
+// NewFieldDescribeFn returns the DescribeFn passing the rule descriptors of the field to the fn,
+// it returns nil if the fn is nil.
+func NewFieldDescribeFn(field fmap.Field, fn func(field fmap.Field, r Rule)) DescribeFn {
+	if fn == nil {
+		return nil
+	}
+	return func(r Rule) {
+		fn(field, r)
+	}
+}
+
+// WithScope returns the function setting the scope of the rule descriptors.
+func (fn DescribeFn) WithScope(scope RuleScope) DescribeFn {
+	if fn == nil {
+		return nil
+	}
+	return func(r Rule) {
+		r.Scope = scope
+		fn(r)
+	}
+}
+
+// conditional returns the function marking the rule descriptors as conditional,
+// the value transformations of the normalization rules are applied only if the whenFn returns true.
+func (fn DescribeFn) conditional(whenFn func(ctx context.Context, value any) bool) DescribeFn {
+	if fn == nil {
+		return nil
+	}
+	return func(r Rule) {
+		r.Conditional = true
+		if normalize := r.Normalize; normalize != nil {
+			r.Normalize = func(ctx context.Context, v any) {
+				if whenFn(ctx, v) {
+					normalize(ctx, v)
+				}
+			}
+		}
+		fn(r)
+	}
+}
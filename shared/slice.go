@@ -8,6 +8,14 @@ import (
 	"github.com/insei/fmap/v3"
 )
 
+// Sentinel errors of the slice rules, errors.Is reports whether the validation error is the error of the rule.
+var (
+	ErrSliceRequired = NewCodeError(KindSlice, RuleRequired)
+	ErrSliceMinLen   = NewCodeError(KindSlice, RuleMinLen)
+	ErrSliceMaxLen   = NewCodeError(KindSlice, RuleMaxLen)
+	ErrSliceUnique   = NewCodeError(KindSlice, RuleUnique)
+)
+
 func UnsafeValigoSliceCast[T any](slice []*any) []*T {
 	var val any = slice
 	ptr := ((*[2]unsafe.Pointer)(unsafe.Pointer(&val)))[1]
@@ -24,7 +32,10 @@ type SliceFieldConfigurator struct {
 	c      *FieldConfigurator[[]*any]
 }
 
-func makeGetValueSliceFn(field fmap.Field) func(value any) ([]*any, bool) {
+func makeGetValueSliceFn(field fmap.Field) (func(value any) ([]*any, bool), error) {
+	if field == nil {
+		return nil, NewConfigError(nil, "slice field is not found")
+	}
 	fType := field.GetType()
 	ptrToSlice := 1 // All values that comes to validator is a pointer
 	ptrToSliceElem := 0
@@ -33,7 +44,7 @@ func makeGetValueSliceFn(field fmap.Field) func(value any) ([]*any, bool) {
 		fType = fType.Elem()
 	}
 	if fType.Kind() != reflect.Slice {
-		panic("field value is not a slice")
+		return nil, NewConfigError(field, "type %s is not a slice", field.GetType().String())
 	}
 	sliceElemType := fType.Elem()
 	for sliceElemType.Kind() == reflect.Ptr {
@@ -41,7 +52,8 @@ func makeGetValueSliceFn(field fmap.Field) func(value any) ([]*any, bool) {
 		sliceElemType = sliceElemType.Elem()
 	}
 	if ptrToSlice > 2 || ptrToSliceElem > 1 {
-		panic("Are you sure about that? (c)")
+		return nil, NewConfigError(field, "type %s is not supported, only slices, pointers to slices and slices of pointers are supported",
+			field.GetType().String())
 	}
 	switch {
 	case ptrToSlice == 1 && ptrToSliceElem == 0:
@@ -53,7 +65,7 @@ func makeGetValueSliceFn(field fmap.Field) func(value any) ([]*any, bool) {
 				convertedArr = append(convertedArr, &(*arr)[i])
 			}
 			return convertedArr, true
-		}
+		}, nil
 	case ptrToSlice == 2 && ptrToSliceElem == 0:
 		return func(value any) ([]*any, bool) {
 			var convertedArr []*any
@@ -66,17 +78,17 @@ func makeGetValueSliceFn(field fmap.Field) func(value any) ([]*any, bool) {
 				convertedArr = append(convertedArr, &(**arr)[i])
 			}
 			return convertedArr, true
-		}
+		}, nil
 	case ptrToSlice == 1 && ptrToSliceElem == 1:
 		return func(value any) ([]*any, bool) {
 			var convertedArr []*any
 			ptr := ((*[2]unsafe.Pointer)(unsafe.Pointer(&value)))[1]
 			arr := (*[]*any)(ptr)
 			for _, v := range *arr {
-				convertedArr = append(convertedArr, &(*v))
+				convertedArr = append(convertedArr, v)
 			}
 			return convertedArr, true
-		}
+		}, nil
 	case ptrToSlice == 2 && ptrToSliceElem == 1:
 		return func(value any) ([]*any, bool) {
 			var convertedArr []*any
@@ -86,10 +98,10 @@ func makeGetValueSliceFn(field fmap.Field) func(value any) ([]*any, bool) {
 				return nil, false
 			}
 			for _, v := range **arr {
-				convertedArr = append(convertedArr, &(*v))
+				convertedArr = append(convertedArr, v)
 			}
 			return convertedArr, true
-		}
+		}, nil
 	}
 	//ptr := unsafe.Pointer(&convertedArr)
 	//ee := *((*[]*string)(ptr))
@@ -97,17 +109,28 @@ func makeGetValueSliceFn(field fmap.Field) func(value any) ([]*any, bool) {
 	//	*v = "222"
 	//}
 	//fmt.Println(ee)
-	return nil
+	return nil, nil
 }
 
 type SliceFieldConfiguratorParams struct {
 	Field    fmap.Field
 	Helper   Helper
 	AppendFn func(fn FieldValidationFn)
+	// ErrorFn reports the configuration errors, the configuration errors panic if it is nil.
+	ErrorFn ConfigErrorFn
+	// DescribeFn receives the descriptors of the appended rules, it is optional.
+	DescribeFn DescribeFn
+	// ItemsKind is the kind of the slice elements, it prefixes the error codes of the elements rules.
+	ItemsKind string
 }
 
 func NewSliceFieldConfigurator(p SliceFieldConfiguratorParams) *SliceFieldConfigurator {
-	getValueFn := makeGetValueSliceFn(p.Field)
+	getValueFn, err := makeGetValueSliceFn(p.Field)
+	if err != nil {
+		p.ErrorFn.Report(err)
+		// the rules of the invalid field are discarded
+		p.AppendFn, p.DescribeFn = DiscardFieldValidationFn, nil
+	}
 	mk := NewSimpleFieldFnMaker(SimpleFieldFnMakerParams[[]*any]{
 		GetValue: getValueFn,
 		Field:    p.Field,
@@ -117,14 +140,17 @@ func NewSliceFieldConfigurator(p SliceFieldConfiguratorParams) *SliceFieldConfig
 		field:  p.Field,
 		helper: p.Helper,
 		c: NewFieldConfigurator(FieldConfiguratorParams[[]*any]{
-			Maker:    mk,
-			AppendFn: p.AppendFn,
+			Maker:      mk,
+			AppendFn:   p.AppendFn,
+			DescribeFn: p.DescribeFn,
+			Kind:       KindSlice,
+			ItemsKind:  p.ItemsKind,
 		}),
 	}
 }
 
 func (s *SliceFieldConfigurator) MaxLen(maxLen int) *SliceFieldConfigurator {
-	s.c.Append(func(v []*any) bool {
+	s.c.AppendRule(Rule{Name: RuleMaxLen, Params: []any{maxLen}}, func(v []*any) bool {
 		if len(v) > maxLen {
 			return false
 		}
@@ -134,7 +160,7 @@ func (s *SliceFieldConfigurator) MaxLen(maxLen int) *SliceFieldConfigurator {
 }
 
 func (s *SliceFieldConfigurator) MinLen(MinLen int) *SliceFieldConfigurator {
-	s.c.Append(func(v []*any) bool {
+	s.c.AppendRule(Rule{Name: RuleMinLen, Params: []any{MinLen}}, func(v []*any) bool {
 		if len(v) < MinLen {
 			return false
 		}
@@ -144,7 +170,7 @@ func (s *SliceFieldConfigurator) MinLen(MinLen int) *SliceFieldConfigurator {
 }
 
 func (s *SliceFieldConfigurator) Required() *SliceFieldConfigurator {
-	s.c.Append(func(v []*any) bool {
+	s.c.AppendRule(Rule{Name: RuleRequired}, func(v []*any) bool {
 		if v == nil {
 			return false
 		}
@@ -154,27 +180,52 @@ func (s *SliceFieldConfigurator) Required() *SliceFieldConfigurator {
 }
 
 // Custom allows for custom validation logic.
-func (s *SliceFieldConfigurator) Custom(f func(ctx context.Context, h *FieldCustomHelper, value []*any) []Error) *SliceFieldConfigurator {
+func (s *SliceFieldConfigurator) Custom(f func(ctx context.Context, h *FieldCustomHelper, value []*any) []Error, opts ...CustomOption) *SliceFieldConfigurator {
+	customHelper := NewFieldCustomHelper(s.field, s.helper)
+	s.c.CustomAppend(s.c.mk.CustomMake(func(ctx context.Context, h Helper, value any) []Error {
+		return f(ctx, customHelper, value.([]*any))
+	}), opts...)
+	return s
+}
+
+// CustomRule is similar to Custom, but the rule is described with the descriptor r,
+// it is used by the rules implemented with the custom validation logic, i.e. the rules of the slice elements.
+func (s *SliceFieldConfigurator) CustomRule(r Rule, f func(ctx context.Context, h *FieldCustomHelper, value []*any) []Error) *SliceFieldConfigurator {
 	customHelper := NewFieldCustomHelper(s.field, s.helper)
-	s.c.appendFn(s.c.mk.CustomMake(func(ctx context.Context, h Helper, value any) []Error {
+	s.c.CustomAppendRule(r, s.c.mk.CustomMake(func(ctx context.Context, h Helper, value any) []Error {
 		return f(ctx, customHelper, value.([]*any))
 	}))
 	return s
 }
 
+// CustomNormalizer appends the normalization rule described with the descriptor r,
+// the transformFn modifies the slice elements in the normalization phase before the validation rules.
+func (s *SliceFieldConfigurator) CustomNormalizer(r Rule, transformFn func(value []*any)) *SliceFieldConfigurator {
+	s.c.AppendNormalizer(r, transformFn)
+	return s
+}
+
 // When allows for conditional validation based on a given condition.
 func (s *SliceFieldConfigurator) When(whenFn func(ctx context.Context, value []*any) bool) *SliceFieldConfigurator {
 	if whenFn == nil {
 		return s
 	}
-	s.c.appendFn = func(fn FieldValidationFn) {
-		fnWithEnabler := func(ctx context.Context, h Helper, v any) []Error {
-			if !whenFn(ctx, v.([]*any)) {
-				return nil
-			}
-			return fn(ctx, h, v)
-		}
-		s.c.appendFn(fnWithEnabler)
+	getValue, _ := makeGetValueSliceFn(s.field)
+	return &SliceFieldConfigurator{
+		field:  s.field,
+		helper: s.helper,
+		c: s.c.NewWithWhen(func(ctx context.Context, value any) bool {
+			v, _ := getValue(value)
+			return whenFn(ctx, v)
+		}),
+	}
+}
+
+// Bail stops the evaluation of the following rules at the first failed rule.
+func (s *SliceFieldConfigurator) Bail() *SliceFieldConfigurator {
+	return &SliceFieldConfigurator{
+		field:  s.field,
+		helper: s.helper,
+		c:      s.c.Bail(),
 	}
-	return s
 }
@@ -1,7 +1,41 @@
 package shared
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"github.com/insei/fmap/v3"
+)
 
 func TestNewSliceFieldConfigurator(t *testing.T) {
 
 }
+
+func TestSliceFieldConfiguratorWhen(t *testing.T) {
+	type tags struct {
+		Values []string
+	}
+	fields, _ := fmap.Get[tags]()
+	var fns []FieldValidationFn
+	s := NewSliceFieldConfigurator(SliceFieldConfiguratorParams{
+		Field:  fields.MustFind("Values"),
+		Helper: &mockHelper{},
+		AppendFn: func(fn FieldValidationFn) {
+			fns = append(fns, fn)
+		},
+	})
+	s.When(func(ctx context.Context, value []*any) bool {
+		return len(value) > 0
+	}).MinLen(2)
+	if len(fns) != 1 {
+		t.Fatalf("expected 1 validation function, got %d", len(fns))
+	}
+	obj := tags{}
+	if errs := fns[0](context.Background(), &mockHelper{}, &obj.Values); len(errs) != 0 {
+		t.Errorf("expected no errors for empty slice, got %v", errs)
+	}
+	obj.Values = []string{"a"}
+	if errs := fns[0](context.Background(), &mockHelper{}, &obj.Values); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %v", errs)
+	}
+}
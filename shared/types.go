@@ -10,6 +10,10 @@ import (
 // It takes a context, a Helper implementation, and a value, and returns a slice of errors.
 type FieldValidationFn func(ctx context.Context, h Helper, v any) []Error
 
+// FieldNormalizationFn is a function type that represents a field value transformation, i.e. Trim.
+// It takes a context and the same value as FieldValidationFn and modifies the value in place.
+type FieldNormalizationFn func(ctx context.Context, v any)
+
 // BundleDependencies is a struct that represents a bundle of dependencies for field validation.
 type BundleDependencies struct {
 	// Object is the object being validated.
@@ -21,4 +25,8 @@ type BundleDependencies struct {
 	AppendFn func(field fmap.Field, fn FieldValidationFn)
 	// Fields is the storage for the fields being validated.
 	Fields fmap.Storage
+	// ErrorFn reports the configuration errors, the configuration errors panic if it is nil.
+	ErrorFn ConfigErrorFn
+	// DescribeFn receives the descriptors of the field rules, it is optional.
+	DescribeFn func(field fmap.Field, r Rule)
 }
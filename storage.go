@@ -1,67 +1,380 @@
 package valigo
 
 import (
-	"context"
 	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/insei/fmap/v3"
 
 	"github.com/insei/valigo/shared"
 )
 
-// structValidationFn represents a function that validates a struct.
-// It takes a context, a helper, and an object as input, and returns a slice of errors.
-type structValidationFn func(ctx context.Context, h shared.Helper, obj any) []shared.Error
+// fieldsMu guards the fmap package cache and lazily calculated fields data,
+// they are not safe for concurrent use.
+var fieldsMu sync.Mutex
+
+// getFields returns fmap.Storage for the object with all fields data precalculated,
+// so the fields can be used concurrently.
+func getFields(obj any) (fmap.Storage, error) {
+	fieldsMu.Lock()
+	defer fieldsMu.Unlock()
+	fields, err := fmap.GetFrom(obj)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range fields.GetAllPaths() {
+		fields.MustFind(path).GetDereferencedType()
+	}
+	return fields, nil
+}
+
+// getFieldByPtr returns the field of the object by the pointer to the field.
+func getFieldByPtr(obj, fieldPtr any) (fmap.Field, error) {
+	fields, err := getFields(obj)
+	if err != nil {
+		return nil, err
+	}
+	return fields.GetFieldByPtr(obj, fieldPtr)
+}
+
+// registry is an immutable snapshot of the registered validators.
+type registry struct {
+	// rules is a map that stores registered rules for each struct type in the declaration order.
+	rules map[reflect.Type][]rule
+	// plans is a map that stores compiled validation plans for each struct type.
+	plans map[reflect.Type]*plan
+	// explicitNested is a map that stores struct paths of the nested struct fields
+	// configured explicitly for each struct type.
+	explicitNested map[reflect.Type]map[string]struct{}
+	// autoNested is a cache of the nested struct and slice of structs fields for the automatic nested validation,
+	// calculated lazily for the snapshot.
+	autoNested *sync.Map
+	// descriptors is a map that stores descriptors of the registered rules for each struct type
+	// in the declaration order.
+	descriptors map[reflect.Type][]descriptor
+	// normalizers is a map that stores normalization rules for each struct type in the declaration order.
+	normalizers map[reflect.Type][]rule
+	// normalizationPlans is a map that stores compiled normalization plans for each struct type.
+	normalizationPlans map[reflect.Type]*plan
+	// normalizedNested is a cache of the nested struct fields with the normalization rules,
+	// calculated lazily for the snapshot.
+	normalizedNested *sync.Map
+}
+
+// clone returns a shallow copy of the registry with empty autoNested and normalizedNested caches.
+func (r *registry) clone() *registry {
+	return &registry{
+		rules:              cloneMap(r.rules),
+		plans:              cloneMap(r.plans),
+		explicitNested:     cloneMap(r.explicitNested),
+		autoNested:         &sync.Map{},
+		descriptors:        cloneMap(r.descriptors),
+		normalizers:        cloneMap(r.normalizers),
+		normalizationPlans: cloneMap(r.normalizationPlans),
+		normalizedNested:   &sync.Map{},
+	}
+}
+
+// cloneMap returns a shallow copy of the map.
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	c := make(map[K]V, len(m)+1)
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
 
 // storage represents the storage for struct validators.
+//
+// The storage uses copy-on-write snapshots: validation reads the current immutable registry snapshot
+// without locks, configuration serializes writers, copies the registry, modifies the copy and publishes it.
+// So types can be configured lazily while other goroutines are validating.
 type storage struct {
-	// Validators is a map that stores validators for each struct type.
-	// The key is the reflect.Type of the struct, and the value is a slice of structValidationFn.
-	validators map[reflect.Type][]structValidationFn
+	mu       sync.Mutex
+	snapshot atomic.Pointer[registry]
+}
+
+// load returns the current registry snapshot.
+func (s *storage) load() *registry {
+	return s.snapshot.Load()
+}
+
+// update copies the current registry snapshot, applies fn to the copy and publishes it.
+func (s *storage) update(fn func(r *registry)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := s.load().clone()
+	fn(r)
+	s.snapshot.Store(r)
+}
+
+// appendRule appends the rule for the type t and publishes it immediately.
+func (s *storage) appendRule(t reflect.Type, r rule) {
+	b := s.newBatch(t)
+	b.appendRule(r)
+	b.commit()
 }
 
 // newOnStructAppend adds a new struct validator to the storage.
-// It takes a temporary object, an enabler function, and a field validation function as input.
-// The enabler function is optional and can be used to conditionally enable the validation.
-// The field validation function is called for each field of the struct.
-func (s *storage) newOnStructAppend(temp any, enabler func(context.Context, any) bool, fn shared.FieldValidationFn) {
-	t := reflect.TypeOf(temp)
-	fnNew := func(ctx context.Context, h shared.Helper, obj any) []shared.Error {
-		if enabler != nil && !enabler(ctx, obj) {
-			return nil
-		}
-		return fn(ctx, h, obj)
-	}
-	s.validators[t] = append(s.validators[t], fnNew)
+// It takes a temporary object, a condition, a validation function and struct paths of the fields
+// the validation depends on as input.
+// The condition is optional and can be used to conditionally enable the validation.
+// The validation function is called with the pointer to the struct.
+func (s *storage) newOnStructAppend(temp any, cond *condition, fn shared.FieldValidationFn, dependsOn ...string) {
+	s.appendRule(reflect.TypeOf(temp), rule{cond: cond, dependsOn: dependsOn, fn: fn})
 }
 
 // newOnFieldAppend adds a new field validator to the storage.
-// It takes a temporary object, an enabler function, and a field validation function as input.
-// The enabler function is optional and can be used to conditionally enable the validation.
-// The field validation function is called for each field of the struct.
-func (s *storage) newOnFieldAppend(temp any, enabler func(context.Context, any) bool) func(field fmap.Field, fn shared.FieldValidationFn) {
+// It takes a temporary object and a condition as input.
+// The condition is optional and can be used to conditionally enable the validation.
+// The field validation function is called with the pointer to the field of the struct.
+func (s *storage) newOnFieldAppend(temp any, cond *condition) func(field fmap.Field, fn shared.FieldValidationFn) {
 	t := reflect.TypeOf(temp)
 	return func(field fmap.Field, fn shared.FieldValidationFn) {
-		fnNew := func(ctx context.Context, h shared.Helper, obj, v any) []shared.Error {
-			if enabler != nil && !enabler(ctx, obj) {
-				return nil
+		s.appendRule(t, rule{cond: cond, field: field, fn: fn})
+	}
+}
+
+// batch collects the rules, the rules descriptors and the explicitly configured nested fields
+// of a single type configuration, i.e. a single Configure call. They are published to the storage
+// with a single registry snapshot by commit, so the validation never sees a partially configured type
+// and the type plans are compiled once. The changes made after the commit, i.e. with a Configurator
+// used after Configure returned, are published immediately.
+type batch struct {
+	s  *storage
+	t  reflect.Type
+	mu sync.Mutex
+	// rules, descriptors and explicitNested are the changes that are not published yet.
+	rules          []rule
+	descriptors    []descriptor
+	explicitNested []string
+	committed      bool
+}
+
+// newBatch returns a new batch of the changes for the type t.
+func (s *storage) newBatch(t reflect.Type) *batch {
+	return &batch{s: s, t: t}
+}
+
+// appendRule appends the rule to the batch.
+func (b *batch) appendRule(r rule) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rules = append(b.rules, r)
+	if b.committed {
+		b.publish()
+	}
+}
+
+// newOnStructAppend appends a new struct validator to the batch, see storage.newOnStructAppend.
+func (b *batch) newOnStructAppend(cond *condition, fn shared.FieldValidationFn, dependsOn ...string) {
+	b.appendRule(rule{cond: cond, dependsOn: dependsOn, fn: fn})
+}
+
+// newOnFieldAppend returns the function appending a new field validator to the batch, see storage.newOnFieldAppend.
+func (b *batch) newOnFieldAppend(cond *condition) func(field fmap.Field, fn shared.FieldValidationFn) {
+	return func(field fmap.Field, fn shared.FieldValidationFn) {
+		b.appendRule(rule{cond: cond, field: field, fn: fn})
+	}
+}
+
+// describe appends the rule descriptor to the batch,
+// the normalization rules of the fields are registered for the normalization phase.
+func (b *batch) describe(d descriptor) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.descriptors = append(b.descriptors, d)
+	if b.committed {
+		b.publish()
+	}
+}
+
+// newOnFieldDescribe returns the function appending the field rules descriptors to the batch.
+func (b *batch) newOnFieldDescribe(cond *condition) func(field fmap.Field, r shared.Rule) {
+	return func(field fmap.Field, r shared.Rule) {
+		b.describe(descriptor{cond: cond, field: field, rule: r})
+	}
+}
+
+// setExplicitNested marks the nested struct field as explicitly configured,
+// such fields are skipped by the automatic nested validation.
+func (b *batch) setExplicitNested(field fmap.Field) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.explicitNested = append(b.explicitNested, field.GetStructPath())
+	if b.committed {
+		b.publish()
+	}
+}
+
+// commit publishes the collected changes, the following changes are published immediately.
+func (b *batch) commit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.committed = true
+	b.publish()
+}
+
+// publish publishes the collected changes with a single registry snapshot
+// and recompiles the type plans, the caller holds the batch mutex.
+func (b *batch) publish() {
+	if len(b.rules) == 0 && len(b.descriptors) == 0 && len(b.explicitNested) == 0 {
+		return
+	}
+	t := b.t
+	b.s.update(func(r *registry) {
+		if len(b.rules) > 0 {
+			r.rules[t] = append(slices.Clip(r.rules[t]), b.rules...)
+			r.plans[t] = compilePlan(r.rules[t])
+		}
+		if len(b.descriptors) > 0 {
+			r.descriptors[t] = append(slices.Clip(r.descriptors[t]), b.descriptors...)
+			normalizers := slices.Clip(r.normalizers[t])
+			for _, d := range b.descriptors {
+				if d.rule.Normalize != nil && d.field != nil {
+					normalizers = append(normalizers, rule{cond: d.cond, field: d.field, fn: newNormalizationFn(d.rule.Scope, d.rule.Normalize)})
+				}
+			}
+			if len(normalizers) > len(r.normalizers[t]) {
+				r.normalizers[t] = normalizers
+				r.normalizationPlans[t] = compilePlan(normalizers)
 			}
-			return fn(ctx, h, v)
 		}
-		_, ok := s.validators[t]
-		if !ok {
-			s.validators[t] = make([]structValidationFn, 0)
+		if len(b.explicitNested) > 0 {
+			paths := cloneMap(r.explicitNested[t])
+			for _, path := range b.explicitNested {
+				paths[path] = struct{}{}
+			}
+			r.explicitNested[t] = paths
 		}
-		structValidatorFn := func(ctx context.Context, h shared.Helper, obj any) []shared.Error {
-			return fnNew(ctx, h, obj, field.GetPtr(obj))
+	})
+	b.rules, b.descriptors, b.explicitNested = nil, nil, nil
+}
+
+// getDescriptors returns the descriptors of the rules registered for the type t.
+func (s *storage) getDescriptors(t reflect.Type) []descriptor {
+	return s.load().descriptors[t]
+}
+
+// getNormalizationPlan returns the compiled normalization plan for the type t.
+func (s *storage) getNormalizationPlan(t reflect.Type) *plan {
+	return s.load().normalizationPlans[t]
+}
+
+// getPlan returns the compiled validation plan for the type t.
+func (s *storage) getPlan(t reflect.Type) *plan {
+	return s.load().plans[t]
+}
+
+// isExplicitNested checks if the nested struct field with the struct path of the type t is configured explicitly.
+func (s *storage) isExplicitNested(t reflect.Type, path string) bool {
+	_, ok := s.load().explicitNested[t][path]
+	return ok
+}
+
+// getAutoNestedFields returns the top level exported struct, pointer to struct, slice of structs
+// and map of structs fields of the object, that was not configured explicitly.
+func (s *storage) getAutoNestedFields(obj any) []fmap.Field {
+	r := s.load()
+	t := reflect.TypeOf(obj)
+	if fields, ok := r.autoNested.Load(t); ok {
+		return fields.([]fmap.Field)
+	}
+	var nested []fmap.Field
+	if t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct {
+		fields, err := getFields(obj)
+		if err != nil {
+			panic(err)
+		}
+		for _, path := range fields.GetAllPaths() {
+			field := fields.MustFind(path)
+			if strings.Contains(path, ".") || !field.IsExported() {
+				continue
+			}
+			if field.GetDereferencedType().Kind() != reflect.Struct && !isStructSlice(field.GetType()) &&
+				!isStructMap(field.GetType()) {
+				continue
+			}
+			if _, ok := r.explicitNested[t][path]; ok {
+				continue
+			}
+			nested = append(nested, field)
 		}
-		s.validators[t] = append(s.validators[t], structValidatorFn)
 	}
+	r.autoNested.Store(t, nested)
+	return nested
+}
+
+// getNormalizedNestedFields returns the top level exported fields of the pointer to struct type t
+// with the nested struct types having the normalization rules, i.e. struct, slice of structs or map of structs fields.
+// Only the fields validated as nested structs, checked with the validated function, are returned.
+func (s *storage) getNormalizedNestedFields(t reflect.Type, validated func(owner reflect.Type, field fmap.Field) bool) []fmap.Field {
+	r := s.load()
+	if fields, ok := r.normalizedNested.Load(t); ok {
+		return fields.([]fmap.Field)
+	}
+	var nested []fmap.Field
+	if t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct {
+		fields, err := getFields(reflect.New(t.Elem()).Interface())
+		if err != nil {
+			panic(err)
+		}
+		for _, path := range fields.GetAllPaths() {
+			field := fields.MustFind(path)
+			if strings.Contains(path, ".") || !field.IsExported() {
+				continue
+			}
+			if nt, ok := getNestedStructType(field.GetType()); ok && validated(t, field) &&
+				r.hasNormalizers(nt, map[reflect.Type]bool{}) {
+				nested = append(nested, field)
+			}
+		}
+	}
+	r.normalizedNested.Store(t, nested)
+	return nested
+}
+
+// hasNormalizers checks if the pointer to struct type t or the types of its nested struct fields
+// have the normalization rules.
+func (r *registry) hasNormalizers(t reflect.Type, visited map[reflect.Type]bool) bool {
+	if len(r.normalizers[t]) > 0 {
+		return true
+	}
+	if visited[t] {
+		return false
+	}
+	visited[t] = true
+	fields, err := getFields(reflect.New(t.Elem()).Interface())
+	if err != nil {
+		return false
+	}
+	for _, path := range fields.GetAllPaths() {
+		field := fields.MustFind(path)
+		if strings.Contains(path, ".") || !field.IsExported() {
+			continue
+		}
+		if nt, ok := getNestedStructType(field.GetType()); ok && r.hasNormalizers(nt, visited) {
+			return true
+		}
+	}
+	return false
 }
 
 // newStorage creates a new storage object.
 func newStorage() *storage {
-	return &storage{
-		validators: make(map[reflect.Type][]structValidationFn),
-	}
+	s := &storage{}
+	s.snapshot.Store(&registry{
+		rules:              make(map[reflect.Type][]rule),
+		plans:              make(map[reflect.Type]*plan),
+		explicitNested:     make(map[reflect.Type]map[string]struct{}),
+		autoNested:         &sync.Map{},
+		descriptors:        make(map[reflect.Type][]descriptor),
+		normalizers:        make(map[reflect.Type][]rule),
+		normalizationPlans: make(map[reflect.Type]*plan),
+		normalizedNested:   &sync.Map{},
+	})
+	return s
 }
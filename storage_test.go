@@ -3,6 +3,7 @@ package valigo
 import (
 	"context"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/insei/fmap/v3"
@@ -19,9 +20,9 @@ func TestStorageNewOnStruct(t *testing.T) {
 	fn := func(ctx context.Context, h shared.Helper, obj any) []shared.Error {
 		return nil
 	}
-	s.newOnStructAppend(temp, enabler, fn)
-	if len(s.validators[reflect.TypeOf(temp)]) != 1 {
-		t.Errorf("expected 1 validator, got %d", len(s.validators[reflect.TypeOf(temp)]))
+	s.newOnStructAppend(temp, newCondition(enabler), fn)
+	if len(s.load().rules[reflect.TypeOf(temp)]) != 1 {
+		t.Errorf("expected 1 rule, got %d", len(s.load().rules[reflect.TypeOf(temp)]))
 	}
 }
 
@@ -32,9 +33,9 @@ func TestStorageNewOnStructWithNilEnabler(t *testing.T) {
 	fn := func(ctx context.Context, h shared.Helper, obj any) []shared.Error {
 		return nil
 	}
-	s.newOnStructAppend(temp, enabler, fn)
-	if len(s.validators[reflect.TypeOf(temp)]) != 1 {
-		t.Errorf("expected 1 validator, got %d", len(s.validators[reflect.TypeOf(temp)]))
+	s.newOnStructAppend(temp, newCondition(enabler), fn)
+	if len(s.load().rules[reflect.TypeOf(temp)]) != 1 {
+		t.Errorf("expected 1 rule, got %d", len(s.load().rules[reflect.TypeOf(temp)]))
 	}
 }
 
@@ -58,9 +59,9 @@ func TestStorageNewOnField(t *testing.T) {
 	fn := func(ctx context.Context, h shared.Helper, obj any) []shared.Error {
 		return nil
 	}
-	s.newOnFieldAppend(temp, enabler)(field, fn)
-	if len(s.validators[reflect.TypeOf(temp)]) != 1 {
-		t.Errorf("expected 1 validator, got %d", len(s.validators[reflect.TypeOf(temp)]))
+	s.newOnFieldAppend(temp, newCondition(enabler))(field, fn)
+	if len(s.load().rules[reflect.TypeOf(temp)]) != 1 {
+		t.Errorf("expected 1 rule, got %d", len(s.load().rules[reflect.TypeOf(temp)]))
 	}
 }
 
@@ -77,8 +78,103 @@ func TestStorageNewOnFieldWithNilEnabler(t *testing.T) {
 	fn := func(ctx context.Context, h shared.Helper, obj any) []shared.Error {
 		return nil
 	}
-	s.newOnFieldAppend(temp, enabler)(field, fn)
-	if len(s.validators[reflect.TypeOf(temp)]) != 1 {
-		t.Errorf("expected 1 validator, got %d", len(s.validators[reflect.TypeOf(temp)]))
+	s.newOnFieldAppend(temp, newCondition(enabler))(field, fn)
+	if len(s.load().rules[reflect.TypeOf(temp)]) != 1 {
+		t.Errorf("expected 1 rule, got %d", len(s.load().rules[reflect.TypeOf(temp)]))
+	}
+}
+
+func TestStorageConcurrentConfigureAndValidate(t *testing.T) {
+	type first struct {
+		Name string
+	}
+	type second struct {
+		Name  string
+		First first
+	}
+	v := New(WithAutoNestedValidation())
+	Configure[first](v, func(c Configurator[first], obj *first) {
+		c.String(&obj.Name).Required()
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				_ = v.ValidateTyped(context.Background(), &second{})
+				_ = v.ValidateTyped(context.Background(), &first{})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			Configure[second](v, func(c Configurator[second], obj *second) {
+				c.String(&obj.Name).Required()
+				c.Custom(func(ctx context.Context, h shared.StructCustomHelper, obj *second) []shared.Error {
+					return nil
+				})
+			})
+		}()
+	}
+	wg.Wait()
+	errs := v.ValidateTyped(context.Background(), &second{})
+	if len(errs) != 9 {
+		t.Errorf("expected 9 errors, got %d", len(errs))
+	}
+}
+
+func TestStorageConfigurePublishedOnce(t *testing.T) {
+	type form struct {
+		Name string
+		Tags []string
+	}
+	v := New()
+	Configure[form](v, func(c Configurator[form], obj *form) {
+		c.String(&obj.Name).Required()
+		c.StringSlice(&obj.Tags).Required()
+		// the rules are not visible to the validation until the configuration is finished
+		if errs := v.ValidateTyped(context.Background(), &form{}); len(errs) != 0 {
+			t.Errorf("expected no errors during the configuration, got %d", len(errs))
+		}
+	})
+	if errs := v.ValidateTyped(context.Background(), &form{}); len(errs) != 2 {
+		t.Errorf("expected 2 errors, got %d", len(errs))
+	}
+}
+
+func TestStorageConcurrentChainAppendAndValidate(t *testing.T) {
+	type form struct {
+		Name   string
+		Tags   []string
+		Labels map[string]string
+	}
+	v := New()
+	obj := &form{}
+	b := configure[form](v, obj, nil, nil)
+	name := b.String(&obj.Name).Bail()
+	tags := b.sliceElements(&obj.Tags).strings()
+	labels := b.Map(&obj.Labels).StringValues()
+	b.batch.commit()
+	// the rules chains are appended after their validation functions are published
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for j := 0; j < 50; j++ {
+			_ = v.ValidateTyped(context.Background(), &form{Tags: []string{""}, Labels: map[string]string{"a": ""}})
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for j := 0; j < 50; j++ {
+			name.Required()
+			tags.Required()
+			labels.Required()
+		}
+	}()
+	wg.Wait()
+	errs := v.ValidateTyped(context.Background(), &form{Tags: []string{""}, Labels: map[string]string{"a": ""}})
+	if len(errs) != 101 {
+		t.Errorf("expected 101 errors, got %d", len(errs))
 	}
 }
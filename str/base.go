@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/insei/fmap/v3"
 	"github.com/insei/valigo/shared"
@@ -17,30 +18,59 @@ const (
 	regexpLocaleKey    = "validation:string:Doesn't match required regexp pattern"
 	anyOfLocaleKey     = "validation:string:Only %s values is allowed"
 	emailLocaleKey     = "validation:string:Should be email address"
+	eqFieldLocaleKey   = "validation:string:Should be equal to %s"
+	neFieldLocaleKey   = "validation:string:Should not be equal to %s"
 
 	emailRegexp = `^[^\s,@#$%^&*!()]+@([a-zA-Z0-9]+[.])+[a-zA-Z]{2,8}$`
 )
 
+// Sentinel errors of the string rules, errors.Is reports whether the validation error is the error of the rule,
+// the errors of the string slices elements rules are reported with the same codes.
+var (
+	ErrRequired = shared.NewCodeError(shared.KindString, shared.RuleRequired)
+	ErrMinLen   = shared.NewCodeError(shared.KindString, shared.RuleMinLen)
+	ErrMaxLen   = shared.NewCodeError(shared.KindString, shared.RuleMaxLen)
+	ErrMinRunes = shared.NewCodeError(shared.KindString, shared.RuleMinRunes)
+	ErrMaxRunes = shared.NewCodeError(shared.KindString, shared.RuleMaxRunes)
+	ErrRegexp   = shared.NewCodeError(shared.KindString, shared.RuleRegexp)
+	ErrAnyOf    = shared.NewCodeError(shared.KindString, shared.RuleAnyOf)
+	ErrEmail    = shared.NewCodeError(shared.KindString, shared.RuleEmail)
+	ErrEqField  = shared.NewCodeError(shared.KindString, shared.RuleEqField)
+	ErrNeField  = shared.NewCodeError(shared.KindString, shared.RuleNeField)
+)
+
 type baseConfigurator[T strPtr] struct {
-	c     *shared.FieldConfigurator[T]
-	field fmap.Field
-	h     shared.Helper
+	c      *shared.FieldConfigurator[T]
+	field  fmap.Field
+	h      shared.Helper
+	fields fmap.Storage
+	obj    any
+	errFn  shared.ConfigErrorFn
 }
 
-// Trim removes leading and trailing whitespace from the string value.
+// Trim removes leading and trailing whitespace from the string value in the normalization phase,
+// before the validation rules.
 func (i *baseConfigurator[T]) Trim() BaseConfigurator {
-	i.c.Append(func(v T) bool {
+	i.c.AppendNormalizer(shared.Rule{Name: shared.RuleTrim}, func(v T) {
 		if v != nil {
 			*v = strings.TrimSpace(*v)
 		}
-		return true
-	}, "")
+	})
+	return i
+}
+
+// Default sets the value to the empty string field in the normalization phase, before the validation rules,
+// the nil pointer field is set to the pointer to the value.
+func (i *baseConfigurator[T]) Default(value string) BaseConfigurator {
+	i.c.AppendDefault(shared.Rule{Name: shared.RuleDefault, Params: []any{value}}, func() (any, bool) {
+		return value, true
+	})
 	return i
 }
 
 // MaxLen checks if the string length exceeds the maximum allowed length.
 func (i *baseConfigurator[T]) MaxLen(maxLen int) BaseConfigurator {
-	i.c.Append(func(v T) bool {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleMaxLen, Params: []any{maxLen}}, func(v T) bool {
 		return len(*v) <= maxLen
 	}, maxLengthLocaleKey, maxLen)
 
@@ -49,16 +79,34 @@ func (i *baseConfigurator[T]) MaxLen(maxLen int) BaseConfigurator {
 
 // MinLen checks if the string length is not less than the given minimum length.
 func (i *baseConfigurator[T]) MinLen(minLen int) BaseConfigurator {
-	i.c.Append(func(v T) bool {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleMinLen, Params: []any{minLen}}, func(v T) bool {
 		return len(*v) >= minLen
 	}, minLengthLocaleKey, minLen)
 
 	return i
 }
 
+// MaxRunes checks if the count of the string characters (runes) exceeds the maximum allowed count.
+func (i *baseConfigurator[T]) MaxRunes(maxRunes int) BaseConfigurator {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleMaxRunes, Params: []any{maxRunes}}, func(v T) bool {
+		return utf8.RuneCountInString(*v) <= maxRunes
+	}, maxLengthLocaleKey, maxRunes)
+
+	return i
+}
+
+// MinRunes checks if the count of the string characters (runes) is not less than the given minimum count.
+func (i *baseConfigurator[T]) MinRunes(minRunes int) BaseConfigurator {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleMinRunes, Params: []any{minRunes}}, func(v T) bool {
+		return utf8.RuneCountInString(*v) >= minRunes
+	}, minLengthLocaleKey, minRunes)
+
+	return i
+}
+
 // Required checks if the string is not empty.
 func (i *baseConfigurator[T]) Required() BaseConfigurator {
-	i.c.Append(func(v T) bool {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleRequired}, func(v T) bool {
 		return len(*v) > 0
 	}, requiredLocaleKey)
 
@@ -66,11 +114,11 @@ func (i *baseConfigurator[T]) Required() BaseConfigurator {
 }
 
 // Custom allows for custom validation logic to be applied to the string value.
-func (i *baseConfigurator[T]) Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error) BaseConfigurator {
+func (i *baseConfigurator[T]) Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error, opts ...shared.CustomOption) BaseConfigurator {
 	customHelper := shared.NewFieldCustomHelper(i.field, i.h)
 	i.c.CustomAppend(func(ctx context.Context, h shared.Helper, value any) []shared.Error {
 		return f(ctx, customHelper, value)
-	})
+	}, opts...)
 	return i
 }
 
@@ -82,7 +130,7 @@ func (i *baseConfigurator[T]) Regexp(regexp *regexp.Regexp, opts ...RegexpOption
 	for _, opt := range opts {
 		opt.apply(&options)
 	}
-	i.c.Append(func(v T) bool {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleRegexp, Params: []any{regexp.String()}}, func(v T) bool {
 		return regexp.MatchString(*v)
 	}, options.localeKey)
 	return i
@@ -90,7 +138,7 @@ func (i *baseConfigurator[T]) Regexp(regexp *regexp.Regexp, opts ...RegexpOption
 
 // AnyOf checks if the string value is one of the allowed values.
 func (i *baseConfigurator[T]) AnyOf(allowed ...string) BaseConfigurator {
-	i.c.Append(func(v T) bool {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleAnyOf, Params: shared.RuleParams(allowed)}, func(v T) bool {
 		return slices.Contains(allowed, *v)
 	}, anyOfLocaleKey, allowed)
 	return i
@@ -98,13 +146,48 @@ func (i *baseConfigurator[T]) AnyOf(allowed ...string) BaseConfigurator {
 
 // Email checks is the string value is email.
 func (i *baseConfigurator[T]) Email() BaseConfigurator {
-	i.c.Append(func(v T) bool {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleEmail}, func(v T) bool {
 		r := regexp.MustCompile(emailRegexp)
 		return r.MatchString(*v)
 	}, emailLocaleKey)
 	return i
 }
 
+// appendCrossField appends the rule comparing the string value with the value of the other field.
+func (i *baseConfigurator[T]) appendCrossField(fieldPtr any, validationFn func(v, other T) bool, rule, localeKey string) {
+	cf, err := shared.NewCrossField(i.fields, i.obj, i.field, fieldPtr)
+	if err != nil {
+		i.errFn.Report(err)
+		return
+	}
+	derefFn := getDerefFn(cf.Field.GetType())
+	if derefFn == nil {
+		i.errFn.Report(shared.NewConfigError(i.field, "cross-field rule other field %s type %s is not supported",
+			cf.Field.GetStructPath(), cf.Field.GetType().String()))
+		return
+	}
+	i.c.AppendCrossFieldRule(shared.Rule{Name: rule, Params: []any{cf.Field.GetStructPath()}}, func(value any) (T, bool) {
+		other, ok := derefFn(cf.Ptr(value))
+		return other, ok && other != nil
+	}, validationFn, localeKey, i.h.FieldLocation(cf.Field))
+}
+
+// EqField checks if the string value is equal to the value of the other field.
+func (i *baseConfigurator[T]) EqField(fieldPtr any) BaseConfigurator {
+	i.appendCrossField(fieldPtr, func(v, other T) bool {
+		return v != nil && *v == *other
+	}, shared.RuleEqField, eqFieldLocaleKey)
+	return i
+}
+
+// NeField checks if the string value is not equal to the value of the other field.
+func (i *baseConfigurator[T]) NeField(fieldPtr any) BaseConfigurator {
+	i.appendCrossField(fieldPtr, func(v, other T) bool {
+		return v == nil || *v != *other
+	}, shared.RuleNeField, neFieldLocaleKey)
+	return i
+}
+
 // When allows for conditional validation logic to be applied to the string value.
 func (i *baseConfigurator[T]) When(whenFn func(ctx context.Context, value any) bool) BaseConfigurator {
 	if whenFn == nil {
@@ -112,8 +195,23 @@ func (i *baseConfigurator[T]) When(whenFn func(ctx context.Context, value any) b
 	}
 	base := i.c.NewWithWhen(whenFn)
 	return &baseConfigurator[T]{
-		c:     base,
-		field: i.field,
-		h:     i.h,
+		c:      base,
+		field:  i.field,
+		h:      i.h,
+		fields: i.fields,
+		obj:    i.obj,
+		errFn:  i.errFn,
+	}
+}
+
+// Bail stops the evaluation of the following rules for the string value at the first failed rule.
+func (i *baseConfigurator[T]) Bail() BaseConfigurator {
+	return &baseConfigurator[T]{
+		c:      i.c.Bail(),
+		field:  i.field,
+		h:      i.h,
+		fields: i.fields,
+		obj:    i.obj,
+		errFn:  i.errFn,
 	}
 }
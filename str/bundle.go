@@ -1,9 +1,11 @@
 package str
 
 import (
+	"reflect"
+
 	"github.com/insei/fmap/v3"
+
 	"github.com/insei/valigo/shared"
-	"reflect"
 )
 
 type strPtr interface {
@@ -17,6 +19,8 @@ type StringBundle struct {
 	storage  fmap.Storage
 	obj      any
 	h        shared.Helper
+	errFn    shared.ConfigErrorFn
+	describe func(field fmap.Field, r shared.Rule)
 }
 
 // NewStringBundle creates a new intBundle instance.
@@ -27,6 +31,8 @@ func NewStringBundle(deps shared.BundleDependencies) *StringBundle {
 		storage:  deps.Fields,
 		obj:      deps.Object,
 		h:        deps.Helper,
+		errFn:    deps.ErrorFn,
+		describe: deps.DescribeFn,
 	}
 }
 
@@ -34,6 +40,14 @@ type baseConfiguratorParams[T strPtr] struct {
 	Field    fmap.Field
 	Helper   shared.Helper
 	AppendFn func(fn shared.FieldValidationFn)
+	// Fields and Object are used to find the other fields for the cross-field rules,
+	// they are nil for the container elements.
+	Fields fmap.Storage
+	Object any
+	// ErrorFn reports the configuration errors, the configuration errors panic if it is nil.
+	ErrorFn shared.ConfigErrorFn
+	// DescribeFn receives the descriptors of the rules, it is optional.
+	DescribeFn shared.DescribeFn
 }
 
 func newBaseConfigurator[T strPtr](p baseConfiguratorParams[T], derefFn func(value any) (*string, bool)) *baseConfigurator[T] {
@@ -46,11 +60,16 @@ func newBaseConfigurator[T strPtr](p baseConfiguratorParams[T], derefFn func(val
 		Helper: p.Helper,
 	})
 	return &baseConfigurator[T]{
-		field: p.Field,
-		h:     p.Helper,
+		field:  p.Field,
+		h:      p.Helper,
+		fields: p.Fields,
+		obj:    p.Object,
+		errFn:  p.ErrorFn,
 		c: shared.NewFieldConfigurator[T](shared.FieldConfiguratorParams[T]{
-			Maker:    mk,
-			AppendFn: p.AppendFn,
+			Maker:      mk,
+			AppendFn:   p.AppendFn,
+			DescribeFn: p.DescribeFn,
+			Kind:       shared.KindString,
 		}),
 	}
 }
@@ -71,19 +90,31 @@ func deref[T strPtr](value any) (T, bool) {
 	return val, true
 }
 
+// getDerefFn returns the dereference function for the pointer to value of the type t.
+func getDerefFn(t reflect.Type) func(value any) (*string, bool) {
+	switch reflect.PointerTo(t) {
+	case reflect.TypeOf(new(string)):
+		return deref
+	case reflect.TypeOf(new(*string)):
+		return ptrDeref
+	}
+	return nil
+}
+
 // String returns a FieldConfigurator instance for an string field.
 // It takes a pointer to a string field as an argument.
+// The configuration error is reported for the unknown field or not a string field,
+// the rules of such field are discarded.
 func (i *StringBundle) String(fieldPtr any) BaseConfigurator {
 	field, err := i.storage.GetFieldByPtr(i.obj, fieldPtr)
-	var derefFn func(value any) (*string, bool)
-	switch reflect.PointerTo(field.GetType()) {
-	case reflect.TypeOf(new(string)):
-		derefFn = deref
-	case reflect.TypeOf(new(*string)):
-		derefFn = ptrDeref
-	}
 	if err != nil {
-		panic(err)
+		i.errFn.Report(shared.NewConfigError(nil, "string field: %v", err))
+		return newDiscardConfigurator(field, i.h)
+	}
+	derefFn := getDerefFn(field.GetType())
+	if derefFn == nil {
+		i.errFn.Report(shared.NewConfigError(field, "type %s is not a string or pointer to string", field.GetType().String()))
+		return newDiscardConfigurator(field, i.h)
 	}
 	return newBaseConfigurator(baseConfiguratorParams[*string]{
 		Field:  field,
@@ -91,5 +122,54 @@ func (i *StringBundle) String(fieldPtr any) BaseConfigurator {
 		AppendFn: func(fn shared.FieldValidationFn) {
 			i.appendFn(field, fn)
 		},
+		Fields:     i.storage,
+		Object:     i.obj,
+		ErrorFn:    i.errFn,
+		DescribeFn: shared.NewFieldDescribeFn(field, i.describe),
+	}, derefFn)
+}
+
+// newDiscardConfigurator returns a BaseConfigurator instance of the invalid field configuration,
+// it discards the rules and the configuration errors.
+func newDiscardConfigurator(field fmap.Field, h shared.Helper) BaseConfigurator {
+	return newBaseConfigurator(baseConfiguratorParams[*string]{
+		Field:    field,
+		Helper:   h,
+		AppendFn: shared.DiscardFieldValidationFn,
+		ErrorFn:  shared.DiscardConfigError,
+	}, deref[*string])
+}
+
+// ElementConfiguratorParams is a struct that represents the parameters for the string element configurator.
+type ElementConfiguratorParams struct {
+	// Type is the type of the element, string or *string.
+	Type reflect.Type
+	// Field is the field that contains the elements, i.e. map or slice field.
+	Field fmap.Field
+	// Helper is the Helper implementation used for validation.
+	Helper shared.Helper
+	// AppendFn is a function that appends an element validation function,
+	// the function takes a pointer to the element value.
+	AppendFn func(fn shared.FieldValidationFn)
+	// ErrorFn reports the configuration errors, the configuration errors panic if it is nil.
+	ErrorFn shared.ConfigErrorFn
+	// DescribeFn receives the descriptors of the element rules, it is optional.
+	DescribeFn shared.DescribeFn
+}
+
+// NewElementConfigurator returns a BaseConfigurator instance for string elements of the container field,
+// such as map keys and values.
+func NewElementConfigurator(p ElementConfiguratorParams) BaseConfigurator {
+	derefFn := getDerefFn(p.Type)
+	if derefFn == nil {
+		p.ErrorFn.Report(shared.NewConfigError(p.Field, "element type %s is not a string or pointer to string", p.Type.String()))
+		return newDiscardConfigurator(p.Field, p.Helper)
+	}
+	return newBaseConfigurator(baseConfiguratorParams[*string]{
+		Field:      p.Field,
+		Helper:     p.Helper,
+		AppendFn:   p.AppendFn,
+		ErrorFn:    p.ErrorFn,
+		DescribeFn: p.DescribeFn,
 	}, derefFn)
 }
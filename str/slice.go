@@ -16,22 +16,32 @@ type StringSliceFieldConfigurator struct {
 }
 
 func NewStringSliceFieldConfigurator(p shared.SliceFieldConfiguratorParams) *StringSliceFieldConfigurator {
+	p.ItemsKind = shared.KindString
 	return &StringSliceFieldConfigurator{
 		shared.NewSliceFieldConfigurator(p),
 	}
 }
 
+// Trim removes leading and trailing whitespace from each string in the slice in the normalization phase,
+// before the validation rules.
 func (s *StringSliceFieldConfigurator) Trim() *StringSliceFieldConfigurator {
-	s.Custom(func(ctx context.Context, h *shared.FieldCustomHelper, v []*any) []shared.Error {
-		values := shared.UnsafeValigoSliceCast[string](v)
-		for _, val := range values {
-			*val = strings.TrimSpace(*val)
+	s.CustomNormalizer(shared.Rule{Name: shared.RuleTrim}, func(v []*any) {
+		for _, val := range shared.UnsafeValigoSliceCast[string](v) {
+			if val != nil {
+				*val = strings.TrimSpace(*val)
+			}
 		}
-		return nil
 	})
 	return s
 }
 
+// Bail stops the evaluation of the following rules at the first failed rule.
+func (s *StringSliceFieldConfigurator) Bail() *StringSliceFieldConfigurator {
+	return &StringSliceFieldConfigurator{
+		s.SliceFieldConfigurator.Bail(),
+	}
+}
+
 func (s *StringSliceFieldConfigurator) Regexp(regexp *regexp.Regexp, opts ...RegexpOption) *StringSliceFieldConfigurator {
 	options := regexpOptions{
 		localeKey: regexpLocaleKey,
@@ -39,7 +49,7 @@ func (s *StringSliceFieldConfigurator) Regexp(regexp *regexp.Regexp, opts ...Reg
 	for _, opt := range opts {
 		opt.apply(&options)
 	}
-	s.Custom(func(ctx context.Context, h *shared.FieldCustomHelper, v []*any) []shared.Error {
+	s.CustomRule(shared.Rule{Name: shared.RuleRegexp, Params: []any{regexp.String()}, Scope: shared.RuleScopeItems, LocaleKey: options.localeKey}, func(ctx context.Context, h *shared.FieldCustomHelper, v []*any) []shared.Error {
 		values := shared.UnsafeValigoSliceCast[string](v)
 		var errs []shared.Error
 		for _, val := range values {
@@ -54,7 +64,7 @@ func (s *StringSliceFieldConfigurator) Regexp(regexp *regexp.Regexp, opts ...Reg
 }
 
 func (s *StringSliceFieldConfigurator) Email() *StringSliceFieldConfigurator {
-	s.Custom(func(ctx context.Context, h *shared.FieldCustomHelper, v []*any) []shared.Error {
+	s.CustomRule(shared.Rule{Name: shared.RuleEmail, Scope: shared.RuleScopeItems, LocaleKey: emailLocaleKey}, func(ctx context.Context, h *shared.FieldCustomHelper, v []*any) []shared.Error {
 		values := shared.UnsafeValigoSliceCast[string](v)
 		var errs []shared.Error
 		r := regexp.MustCompile(emailRegexp)
@@ -7,9 +7,11 @@ import (
 )
 
 type BaseConfigurator interface {
-	// Trim removes leading and trailing whitespace from the string.
+	// Trim removes leading and trailing whitespace from the string in the normalization phase.
 	Trim() BaseConfigurator
 
+	// Default sets the value to the empty string field in the normalization phase.
+	Default(value string) BaseConfigurator
 	// Required checks if the string is not empty.
 	Required() BaseConfigurator
 
@@ -17,7 +19,7 @@ type BaseConfigurator interface {
 	AnyOf(allowed ...string) BaseConfigurator
 
 	// Custom allows for custom validation logic.
-	Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error) BaseConfigurator
+	Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error, opts ...shared.CustomOption) BaseConfigurator
 
 	// Regexp checks if the string matches the given regular expression.
 	Regexp(regexp *regexp.Regexp, opts ...RegexpOption) BaseConfigurator
@@ -28,11 +30,26 @@ type BaseConfigurator interface {
 	// MinLen checks if the string length is not less than the given minimum length.
 	MinLen(int) BaseConfigurator
 
+	// MaxRunes checks if the count of the string characters (runes) is not greater than the given maximum count.
+	MaxRunes(int) BaseConfigurator
+
+	// MinRunes checks if the count of the string characters (runes) is not less than the given minimum count.
+	MinRunes(int) BaseConfigurator
+
 	// Email checks is the string is email address
 	Email() BaseConfigurator
 
+	// EqField checks if the string is equal to the value of the other field of the struct.
+	EqField(fieldPtr any) BaseConfigurator
+
+	// NeField checks if the string is not equal to the value of the other field of the struct.
+	NeField(fieldPtr any) BaseConfigurator
+
 	// When allows for conditional validation based on a given condition.
 	When(whenFn func(ctx context.Context, value any) bool) BaseConfigurator
+
+	// Bail stops the evaluation of the following rules at the first failed rule.
+	Bail() BaseConfigurator
 }
 
 // StringBundleConfigurator is a builder interface for a bundle of string fields.
@@ -0,0 +1,696 @@
+package valigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	guuid "github.com/google/uuid"
+	"github.com/insei/fmap/v3"
+
+	"github.com/insei/valigo/num"
+	"github.com/insei/valigo/shared"
+	"github.com/insei/valigo/str"
+	"github.com/insei/valigo/uuid"
+)
+
+const (
+	// valigoTagKey is the key of the valigo validation tag, i.e. `valigo:"required,min=3,max=64,email"`.
+	valigoTagKey = "valigo"
+)
+
+// ErrInvalidTags is the configuration error of the invalid validation tags,
+// all tags configuration errors wrap it.
+var ErrInvalidTags = errors.New("invalid validation tags")
+
+// tagRule is a single rule of the validation tag, i.e. "min=3".
+type tagRule struct {
+	name  string
+	param string
+}
+
+// parseTag parses the validation tag value to the rules.
+func parseTag(tag string) []tagRule {
+	var rules []tagRule
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, param, _ := strings.Cut(part, "=")
+		rules = append(rules, tagRule{name: name, param: param})
+	}
+	return rules
+}
+
+// tagConfigurator is a non-generic part of the Configurator used by the tags configuration.
+type tagConfigurator interface {
+	str.StringBundleConfigurator
+	num.NumberBundleConfigurator
+	uuid.UUIDBundleConfigurator
+	StringSlice(sliceFieldPtr any) *str.StringSliceFieldConfigurator
+	UUIDSlice(sliceFieldPtr any) *uuid.UUIDSliceFieldConfigurator
+	Slice(sliceFieldPtr any) *shared.SliceFieldConfigurator
+	Struct(structFieldPtr any) *StructFieldConfigurator
+	StructSlice(sliceFieldPtr any) *StructSliceFieldConfigurator
+	Map(mapFieldPtr any) *MapFieldConfigurator
+	// whenFieldNotEmpty returns the configurator with the rules enabled only for the not empty field value.
+	whenFieldNotEmpty(field fmap.Field) tagConfigurator
+	// sliceElements returns the configurator of the rules applied to each slice element.
+	sliceElements(sliceFieldPtr any) *sliceElements
+}
+
+// whenFieldNotEmpty returns the builder with the rules enabled only if the field value is not the zero value,
+// i.e. not nil pointer, slice or map.
+func (b *builder[T]) whenFieldNotEmpty(field fmap.Field) tagConfigurator {
+	return b.withEnabler(func(ctx context.Context, obj any) bool {
+		return !reflect.ValueOf(field.GetPtr(obj)).Elem().IsZero()
+	})
+}
+
+// sliceElements returns the configurator of the rules applied to each element of the slice field.
+func (b *builder[T]) sliceElements(sliceFieldPtr any) *sliceElements {
+	return newSliceElements(b.v, b.fieldRules(sliceFieldPtr, "slice field"))
+}
+
+// tagFieldKind is a kind of the field supported by the tags configuration.
+type tagFieldKind int
+
+const (
+	tagKindUnsupported tagFieldKind = iota
+	tagKindString
+	tagKindNumber
+	tagKindUUID
+	tagKindStringSlice
+	tagKindUUIDSlice
+	tagKindStructSlice
+	tagKindSlice
+	tagKindStruct
+	tagKindMap
+)
+
+var (
+	stringType = reflect.TypeOf("")
+	uuidType   = reflect.TypeOf(guuid.UUID{})
+)
+
+// getTagFieldKind returns the kind of the field type for the tags configuration.
+func getTagFieldKind(t reflect.Type) tagFieldKind {
+	switch {
+	case t == stringType || t == reflect.PointerTo(stringType):
+		return tagKindString
+	case t == uuidType || t == reflect.PointerTo(uuidType):
+		return tagKindUUID
+	case isNumberType(t):
+		return tagKindNumber
+	case isStructSlice(t):
+		return tagKindStructSlice
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice:
+		switch t.Elem() {
+		case stringType, reflect.PointerTo(stringType):
+			return tagKindStringSlice
+		case uuidType, reflect.PointerTo(uuidType):
+			return tagKindUUIDSlice
+		}
+		return tagKindSlice
+	case reflect.Struct:
+		return tagKindStruct
+	case reflect.Map:
+		return tagKindMap
+	}
+	return tagKindUnsupported
+}
+
+// isNumberType checks if the type is a builtin number type or a pointer to it.
+func isNumberType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.PkgPath() != "" {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// hasExportedFields checks if the struct type has at least one exported field.
+func hasExportedFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+// tagField is a field of the configured struct model.
+type tagField struct {
+	field  fmap.Field
+	ptr    any
+	fields fmap.Storage
+	model  any
+}
+
+// errorf returns the configuration error of the field tag.
+func (f tagField) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: field %s: %s", ErrInvalidTags, f.field.GetStructPath(), fmt.Sprintf(format, args...))
+}
+
+// unknownRule returns the configuration error of the unknown or unsupported for the field type rule.
+func (f tagField) unknownRule(r tagRule) error {
+	return f.errorf("unknown rule %q for type %s", r.name, f.field.GetType().String())
+}
+
+// intParam parses the rule parameter as the int.
+func (f tagField) intParam(r tagRule) (int, error) {
+	n, err := strconv.Atoi(r.param)
+	if err != nil {
+		return 0, f.errorf("rule %q parameter %q is not an integer", r.name, r.param)
+	}
+	return n, nil
+}
+
+// numberParam parses the rule parameter as the number of the field dereferenced type.
+func (f tagField) numberParam(r tagRule) (any, error) {
+	return f.numberParamOf(f.field.GetDereferencedType(), r)
+}
+
+// numberParamOf parses the rule parameter as the number of the type t.
+func (f tagField) numberParamOf(t reflect.Type, r tagRule) (any, error) {
+	n, err := parseNumber(t, r.param)
+	if err != nil {
+		return nil, f.errorf("rule %q parameter %q is not a %s", r.name, r.param, t.String())
+	}
+	return n, nil
+}
+
+// numberParams parses the space separated rule parameters as the numbers of the field dereferenced type.
+func (f tagField) numberParams(r tagRule) ([]any, error) {
+	return f.numberParamsOf(f.field.GetDereferencedType(), r)
+}
+
+// numberParamsOf parses the space separated rule parameters as the numbers of the type t.
+func (f tagField) numberParamsOf(t reflect.Type, r tagRule) ([]any, error) {
+	var values []any
+	for _, p := range strings.Fields(r.param) {
+		n, err := f.numberParamOf(t, tagRule{name: r.name, param: p})
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+// otherFieldPtr returns the pointer to the other field of the model by the struct path from the rule parameter.
+func (f tagField) otherFieldPtr(r tagRule) (any, error) {
+	other, ok := f.fields.Find(r.param)
+	if !ok {
+		return nil, f.errorf("rule %q field %q is not found", r.name, r.param)
+	}
+	if other.GetDereferencedType() != f.field.GetDereferencedType() {
+		return nil, f.errorf("rule %q field %q type %s differs from the field type",
+			r.name, r.param, other.GetType().String())
+	}
+	return other.GetPtr(f.model), nil
+}
+
+// parseNumber parses the string as the number of the type t.
+func parseNumber(t reflect.Type, s string) (any, error) {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, t.Bits())
+		if err != nil {
+			return nil, err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, t.Bits())
+		if err != nil {
+			return nil, err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, t.Bits())
+		if err != nil {
+			return nil, err
+		}
+		v.SetFloat(n)
+	default:
+		return nil, fmt.Errorf("unsupported number type %s", t.String())
+	}
+	return v.Interface(), nil
+}
+
+// tagFieldConfigureFn returns the function configuring the field rules from the parsed tag rules.
+type tagFieldConfigureFn func(f tagField, rules []tagRule) (func(c tagConfigurator), error)
+
+// tagDialect is a set of the validation tag rules.
+type tagDialect struct {
+	// key is the struct tag key.
+	key string
+	// configureField returns the function configuring the field rules.
+	configureField tagFieldConfigureFn
+}
+
+// valigoDialect is the valigo validation tags dialect, i.e. `valigo:"required,min=3,max=64,email"`.
+var valigoDialect = &tagDialect{
+	key:            valigoTagKey,
+	configureField: configureValigoTagField,
+}
+
+// configureValigoTagField returns the function configuring the field rules from the valigo tag rules.
+func configureValigoTagField(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	switch getTagFieldKind(f.field.GetType()) {
+	case tagKindString:
+		return valigoStringRules(f, rules)
+	case tagKindNumber:
+		return valigoNumberRules(f, rules)
+	case tagKindUUID:
+		return valigoUUIDRules(f, rules)
+	case tagKindStringSlice, tagKindUUIDSlice, tagKindSlice, tagKindStructSlice:
+		return valigoSliceRules(f, rules)
+	case tagKindStruct:
+		return valigoStructRules(f, rules)
+	case tagKindMap:
+		return valigoMapRules(f, rules)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return nil, f.errorf("validation tags are not supported for type %s", f.field.GetType().String())
+}
+
+// valigoStringRules returns the function configuring the string field rules.
+func valigoStringRules(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	var steps []func(c str.BaseConfigurator)
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			steps = append(steps, func(c str.BaseConfigurator) { c.Required() })
+		case "trim":
+			steps = append(steps, func(c str.BaseConfigurator) { c.Trim() })
+		case "email":
+			steps = append(steps, func(c str.BaseConfigurator) { c.Email() })
+		case "min", "max":
+			n, err := f.intParam(r)
+			if err != nil {
+				return nil, err
+			}
+			if r.name == "min" {
+				steps = append(steps, func(c str.BaseConfigurator) { c.MinLen(n) })
+			} else {
+				steps = append(steps, func(c str.BaseConfigurator) { c.MaxLen(n) })
+			}
+		case "oneof":
+			allowed := strings.Fields(r.param)
+			steps = append(steps, func(c str.BaseConfigurator) { c.AnyOf(allowed...) })
+		case "eqfield", "nefield":
+			ptr, err := f.otherFieldPtr(r)
+			if err != nil {
+				return nil, err
+			}
+			if r.name == "eqfield" {
+				steps = append(steps, func(c str.BaseConfigurator) { c.EqField(ptr) })
+			} else {
+				steps = append(steps, func(c str.BaseConfigurator) { c.NeField(ptr) })
+			}
+		default:
+			return nil, f.unknownRule(r)
+		}
+	}
+	if len(steps) == 0 {
+		return nil, nil
+	}
+	return func(c tagConfigurator) {
+		sc := c.String(f.ptr)
+		for _, step := range steps {
+			step(sc)
+		}
+	}, nil
+}
+
+// valigoNumberRules returns the function configuring the number field rules.
+func valigoNumberRules(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	var steps []func(c num.BaseConfigurator)
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			steps = append(steps, func(c num.BaseConfigurator) { c.Required() })
+		case "min", "max":
+			n, err := f.numberParam(r)
+			if err != nil {
+				return nil, err
+			}
+			if r.name == "min" {
+				steps = append(steps, func(c num.BaseConfigurator) { c.Min(n) })
+			} else {
+				steps = append(steps, func(c num.BaseConfigurator) { c.Max(n) })
+			}
+		case "oneof":
+			allowed, err := f.numberParams(r)
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, func(c num.BaseConfigurator) { c.AnyOf(allowed...) })
+		case "eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield":
+			ptr, err := f.otherFieldPtr(r)
+			if err != nil {
+				return nil, err
+			}
+			name := r.name
+			steps = append(steps, func(c num.BaseConfigurator) {
+				switch name {
+				case "eqfield":
+					c.EqField(ptr)
+				case "nefield":
+					c.NeField(ptr)
+				case "gtfield":
+					c.GtField(ptr)
+				case "gtefield":
+					c.GteField(ptr)
+				case "ltfield":
+					c.LtField(ptr)
+				case "ltefield":
+					c.LteField(ptr)
+				}
+			})
+		default:
+			return nil, f.unknownRule(r)
+		}
+	}
+	if len(steps) == 0 {
+		return nil, nil
+	}
+	return func(c tagConfigurator) {
+		nc := c.Number(f.ptr)
+		for _, step := range steps {
+			step(nc)
+		}
+	}, nil
+}
+
+// valigoUUIDRules returns the function configuring the uuid field rules.
+func valigoUUIDRules(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	required := false
+	for _, r := range rules {
+		if r.name != "required" {
+			return nil, f.unknownRule(r)
+		}
+		required = true
+	}
+	if !required {
+		return nil, nil
+	}
+	return func(c tagConfigurator) {
+		c.UUID(f.ptr).Required()
+	}, nil
+}
+
+// valigoSliceRules returns the function configuring the slice field rules,
+// each element of the slice of structs is validated with the rules of the element type.
+func valigoSliceRules(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	kind := getTagFieldKind(f.field.GetType())
+	var steps []func(c *shared.SliceFieldConfigurator)
+	var elemSteps []func(c *str.StringSliceFieldConfigurator)
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			steps = append(steps, func(c *shared.SliceFieldConfigurator) { c.Required() })
+		case "min", "max":
+			n, err := f.intParam(r)
+			if err != nil {
+				return nil, err
+			}
+			if r.name == "min" {
+				steps = append(steps, func(c *shared.SliceFieldConfigurator) { c.MinLen(n) })
+			} else {
+				steps = append(steps, func(c *shared.SliceFieldConfigurator) { c.MaxLen(n) })
+			}
+		case "trim", "email":
+			if kind != tagKindStringSlice {
+				return nil, f.unknownRule(r)
+			}
+			if r.name == "trim" {
+				elemSteps = append(elemSteps, func(c *str.StringSliceFieldConfigurator) { c.Trim() })
+			} else {
+				elemSteps = append(elemSteps, func(c *str.StringSliceFieldConfigurator) { c.Email() })
+			}
+		default:
+			return nil, f.unknownRule(r)
+		}
+	}
+	if len(steps) == 0 && len(elemSteps) == 0 && kind != tagKindStructSlice {
+		return nil, nil
+	}
+	return func(c tagConfigurator) {
+		var sc *shared.SliceFieldConfigurator
+		switch kind {
+		case tagKindStringSlice:
+			ssc := c.StringSlice(f.ptr)
+			for _, step := range elemSteps {
+				step(ssc)
+			}
+			sc = ssc.SliceFieldConfigurator
+		case tagKindUUIDSlice:
+			sc = c.UUIDSlice(f.ptr).SliceFieldConfigurator
+		case tagKindStructSlice:
+			sc = c.StructSlice(f.ptr).SliceFieldConfigurator
+		default:
+			sc = c.Slice(f.ptr)
+		}
+		for _, step := range steps {
+			step(sc)
+		}
+	}, nil
+}
+
+// valigoStructRules returns the function configuring the nested struct field rules,
+// the nested struct is validated with the rules of its type.
+func valigoStructRules(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	required := false
+	for _, r := range rules {
+		if r.name != "required" || f.field.GetType().Kind() != reflect.Ptr {
+			return nil, f.unknownRule(r)
+		}
+		required = true
+	}
+	if !required && !hasExportedFields(f.field.GetDereferencedType()) {
+		return nil, nil
+	}
+	return func(c tagConfigurator) {
+		sc := c.Struct(f.ptr)
+		if required {
+			sc.Required()
+		}
+	}, nil
+}
+
+// valigoMapRules returns the function configuring the map field rules.
+func valigoMapRules(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	var steps []func(c *MapFieldConfigurator)
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			steps = append(steps, func(c *MapFieldConfigurator) { c.Required() })
+		case "min", "max":
+			n, err := f.intParam(r)
+			if err != nil {
+				return nil, err
+			}
+			if r.name == "min" {
+				steps = append(steps, func(c *MapFieldConfigurator) { c.MinEntries(n) })
+			} else {
+				steps = append(steps, func(c *MapFieldConfigurator) { c.MaxEntries(n) })
+			}
+		default:
+			return nil, f.unknownRule(r)
+		}
+	}
+	if len(steps) == 0 {
+		return nil, nil
+	}
+	return func(c tagConfigurator) {
+		mc := c.Map(f.ptr)
+		for _, step := range steps {
+			step(mc)
+		}
+	}, nil
+}
+
+// tagsOnce guards the tags configuration of a single type.
+type tagsOnce struct {
+	once   sync.Once
+	nested []reflect.Type
+	err    error
+}
+
+// tagsCacheKey is a key of the tags configuration cache.
+type tagsCacheKey struct {
+	dialect *tagDialect
+	t       reflect.Type
+}
+
+// configureFromTags configures the type t (pointer to struct) and the types of its nested structs
+// from the tags of the dialect. Each type is configured once per validator and dialect.
+func (v *Validator) configureFromTags(d *tagDialect, t reflect.Type) error {
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: type %s is not a pointer to struct", ErrInvalidTags, t.String())
+	}
+	var errs []error
+	queue := []reflect.Type{t}
+	for len(queue) > 0 {
+		t, queue = queue[0], queue[1:]
+		o, loaded := v.tagsConfigured.LoadOrStore(tagsCacheKey{dialect: d, t: t}, &tagsOnce{})
+		to := o.(*tagsOnce)
+		to.once.Do(func() {
+			to.nested, to.err = v.configureTypeFromTags(d, t)
+		})
+		if to.err != nil {
+			errs = append(errs, to.err)
+		}
+		if !loaded {
+			queue = append(queue, to.nested...)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// configureTypeFromTags configures the rules of the type t (pointer to struct) from the tags of the dialect.
+// The rules are registered only if all tags are valid. It returns the pointer types of the nested structs.
+func (v *Validator) configureTypeFromTags(d *tagDialect, t reflect.Type) ([]reflect.Type, error) {
+	model := reflect.New(t.Elem()).Interface()
+	// allocate all pointer fields values recursively
+	if err := zero(model); err != nil {
+		return nil, err
+	}
+	fields, err := getFields(model)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		errs   []error
+		fns    []func(c tagConfigurator)
+		nested []reflect.Type
+	)
+	for _, path := range fields.GetAllPaths() {
+		if strings.Contains(path, ".") {
+			continue
+		}
+		field := fields.MustFind(path)
+		tag, hasTag := field.GetTag().Lookup(d.key)
+		if tag == "-" {
+			continue
+		}
+		if !field.IsExported() {
+			if hasTag {
+				errs = append(errs, fmt.Errorf("%w: field %s: validation tags are not supported for unexported fields",
+					ErrInvalidTags, path))
+			}
+			continue
+		}
+		f := tagField{field: field, ptr: field.GetPtr(model), fields: fields, model: model}
+		fn, err := d.configureField(f, parseTag(tag))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if fn == nil {
+			continue
+		}
+		fns = append(fns, fn)
+		if nestedType, ok := getNestedStructType(field.GetType()); ok {
+			nested = append(nested, nestedType)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	if len(fns) > 0 {
+		c := configure[any](v, model, nil, func(err error) {
+			errs = append(errs, fmt.Errorf("%s: %w", t.Elem().String(), err))
+		})
+		for _, fn := range fns {
+			fn(c)
+		}
+		if len(errs) > 0 {
+			return nil, errors.Join(errs...)
+		}
+		// publish all the type rules at once
+		c.batch.commit()
+	}
+	return nested, nil
+}
+
+// configureLazyFromTags configures the type of the obj from the tags on the first validation,
+// the objects of other types than pointer to struct are skipped.
+func (v *Validator) configureLazyFromTags(obj any) error {
+	t := reflect.TypeOf(obj)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	return v.configureFromTags(v.tagDialect, t)
+}
+
+// structType returns the struct type of the struct or pointer to struct type t,
+// the type is configured from the struct tags if the tags configuration is enabled.
+func (v *Validator) structType(t reflect.Type) (reflect.Type, error) {
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("type %v is not a struct or pointer to struct", t)
+	}
+	if v.tagDialect != nil && v.storage.getPlan(reflect.PointerTo(t)) == nil {
+		if err := v.configureFromTags(v.tagDialect, reflect.PointerTo(t)); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// getNestedStructType returns the pointer to the struct type of the struct, pointer to struct,
+// slice of structs or map of structs type t.
+func getNestedStructType(t reflect.Type) (reflect.Type, bool) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, false
+	}
+	return reflect.PointerTo(t), true
+}
+
+// ConfigureFromTags configures a Validator instance for a specific type T, i.e.: var t *T,
+// from the valigo struct tags, i.e. `valigo:"required,min=3,max=64,email"`.
+// The types of the nested struct fields are configured from their tags too.
+// It returns the error wrapping ErrInvalidTags for unknown rules or rules on unsupported types,
+// the rules of the type are registered only if all its tags are valid.
+//
+// Supported rules:
+//   - strings: required, trim, email, min, max (length), oneof (space separated values), eqfield, nefield;
+//   - numbers: required, min, max, oneof, eqfield, nefield, gtfield, gtefield, ltfield, ltefield;
+//   - uuid.UUID: required;
+//   - slices: required, min, max (length), trim and email for the slices of strings;
+//   - maps: required, min, max (entries count);
+//   - pointers to structs: required.
+//
+// The cross-field rules take the struct path of the other field, i.e. `valigo:"eqfield=Password"`.
+func ConfigureFromTags[T any](v *Validator) error {
+	return v.configureFromTags(valigoDialect, reflect.TypeOf(new(T)))
+}
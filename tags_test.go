@@ -0,0 +1,150 @@
+package valigo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type tagsAddress struct {
+	City string `valigo:"required,max=10"`
+}
+
+type tagsUser struct {
+	ID       uuid.UUID         `valigo:"required"`
+	Name     string            `valigo:"trim,required,min=3,max=8"`
+	Email    *string           `valigo:"email"`
+	Role     string            `valigo:"oneof=admin user"`
+	Age      int               `valigo:"min=18,max=130"`
+	MaxAge   *int              `valigo:"gtefield=Age"`
+	Rate     float64           `valigo:"oneof=0.5 1.5"`
+	Password string            `valigo:"-"`
+	Confirm  string            `valigo:"eqfield=Password"`
+	Tags     []string          `valigo:"required,max=2"`
+	Meta     map[string]string `valigo:"max=1"`
+	Address  *tagsAddress      `valigo:"required"`
+	Addrs    []tagsAddress
+	Skipped  bool
+}
+
+func TestParseTag(t *testing.T) {
+	assert.Equal(t, []tagRule{
+		{name: "required"},
+		{name: "min", param: "3"},
+		{name: "oneof", param: "a b"},
+	}, parseTag("required, min=3,,oneof=a b"))
+	assert.Nil(t, parseTag(""))
+}
+
+func TestConfigureFromTags(t *testing.T) {
+	v := New()
+	assert.NoError(t, ConfigureFromTags[tagsUser](v))
+	// the second call does not register rules twice
+	assert.NoError(t, ConfigureFromTags[tagsUser](v))
+
+	email, maxAge := "user@example.com", 30
+	valid := &tagsUser{
+		ID:       uuid.New(),
+		Name:     "  alex  ",
+		Email:    &email,
+		Role:     "admin",
+		Age:      20,
+		MaxAge:   &maxAge,
+		Rate:     1.5,
+		Password: "secret",
+		Confirm:  "secret",
+		Tags:     []string{"a"},
+		Address:  &tagsAddress{City: "Paris"},
+		Addrs:    []tagsAddress{{City: "Rome"}},
+	}
+	assert.Empty(t, v.ValidateTyped(context.Background(), valid))
+	assert.Equal(t, "alex", valid.Name)
+
+	invalidEmail, invalidMaxAge := "user", 10
+	errs := v.ValidateTyped(context.Background(), &tagsUser{
+		Name:     "al",
+		Email:    &invalidEmail,
+		Role:     "root",
+		Age:      16,
+		MaxAge:   &invalidMaxAge,
+		Rate:     1,
+		Password: "secret",
+		Confirm:  "other",
+		Tags:     []string{"a", "b", "c"},
+		Meta:     map[string]string{"a": "a", "b": "b"},
+		Address:  &tagsAddress{},
+		Addrs:    []tagsAddress{{City: "Very long city name"}},
+	})
+	var locations []string
+	for _, err := range errs {
+		locations = append(locations, err.Location)
+	}
+	assert.Equal(t, []string{"ID", "Name", "Email", "Role", "Age", "MaxAge", "Rate", "Confirm", "Tags", "Meta",
+		"Address.City", "Addrs[0].City"}, locations)
+}
+
+func TestConfigureFromTagsErrors(t *testing.T) {
+	type unknownRule struct {
+		Name string `valigo:"required,unknown"`
+	}
+	type invalidParam struct {
+		Age int8 `valigo:"max=1000"`
+	}
+	type unsupportedType struct {
+		Ch chan int `valigo:"required"`
+	}
+	type unexported struct {
+		name string `valigo:"required"`
+	}
+	type unknownField struct {
+		Name string `valigo:"eqfield=Other"`
+	}
+	type ruleOnType struct {
+		ID uuid.UUID `valigo:"min=1"`
+	}
+	type nestedError struct {
+		Name   string `valigo:"required"`
+		Nested struct {
+			Name string `valigo:"max=abc"`
+		}
+	}
+	v := New()
+	assert.ErrorIs(t, ConfigureFromTags[unknownRule](v), ErrInvalidTags)
+	assert.ErrorIs(t, ConfigureFromTags[invalidParam](v), ErrInvalidTags)
+	assert.ErrorIs(t, ConfigureFromTags[unsupportedType](v), ErrInvalidTags)
+	assert.ErrorIs(t, ConfigureFromTags[unexported](v), ErrInvalidTags)
+	assert.ErrorIs(t, ConfigureFromTags[unknownField](v), ErrInvalidTags)
+	assert.ErrorIs(t, ConfigureFromTags[ruleOnType](v), ErrInvalidTags)
+	assert.ErrorIs(t, ConfigureFromTags[int](v), ErrInvalidTags)
+	assert.ErrorContains(t, ConfigureFromTags[unknownRule](v), `field Name: unknown rule "unknown" for type string`)
+	assert.ErrorIs(t, ConfigureFromTags[nestedError](v), ErrInvalidTags)
+	// the rules of the invalid type are not registered
+	assert.Empty(t, v.ValidateTyped(context.Background(), &unknownRule{}))
+	// the rules of the valid type with invalid nested type are registered
+	assert.Len(t, v.ValidateTyped(context.Background(), &nestedError{}), 1)
+}
+
+func TestWithTagsConfiguration(t *testing.T) {
+	type invalid struct {
+		Name string `valigo:"required,unknown"`
+	}
+	v := New(WithTagsConfiguration())
+	errs := v.ValidateTyped(context.Background(), &tagsAddress{})
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "City", errs[0].Location)
+
+	errs = v.ValidateTyped(context.Background(), &invalid{})
+	assert.Len(t, errs, 1)
+	assert.True(t, errors.Is(errs[0], ErrInvalidTags))
+
+	// explicitly configured types are not configured from tags
+	Configure[tagsUser](v, func(c Configurator[tagsUser], obj *tagsUser) {
+		c.String(&obj.Password).Required()
+	})
+	errs = v.ValidateTyped(context.Background(), &tagsUser{})
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Password", errs[0].Location)
+}
@@ -117,3 +117,9 @@ func GetPreferredLanguagesFromContext(ctx context.Context) []string {
 	preferredLanguages, _ := preferredAny.([]string)
 	return preferredLanguages
 }
+
+// WithPreferredLanguages returns the copy of the ctx with the preferred languages,
+// the languages are read with GetPreferredLanguagesFromContext.
+func WithPreferredLanguages(ctx context.Context, langs ...string) context.Context {
+	return context.WithValue(ctx, languagesContextKeyVal, langs)
+}
@@ -105,6 +105,14 @@ func TestGetPreferredLanguagesFromContext(t *testing.T) {
 	}
 }
 
+func TestWithPreferredLanguages(t *testing.T) {
+	ctx := WithPreferredLanguages(context.Background(), "ru", "en")
+	preferredLanguages := GetPreferredLanguagesFromContext(ctx)
+	if !slicesEqual(preferredLanguages, []string{"ru", "en"}) {
+		t.Errorf("expected preferred languages to be [\"ru\", \"en\"], got %v", preferredLanguages)
+	}
+}
+
 func TestSortQuotient(t *testing.T) {
 	q := sortQuotient{
 		{quotient: 3.0},
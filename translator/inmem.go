@@ -1,10 +1,15 @@
 package translator
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 // inMemTranslatorStorage represents an in-memory storage for translations.
 // It maps languages to maps of translation keys to translated values.
+// It is safe for concurrent use, translations can be added while other goroutines are reading them.
 type inMemTranslatorStorage struct {
+	mu           sync.RWMutex
 	translations map[string]map[string]string
 }
 
@@ -28,26 +33,41 @@ func (t *inMemTranslatorStorage) Add(lang string, data map[string]string) {
 	if data == nil {
 		return
 	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.add(lang, data)
+}
+
+// add adds new translations for a given language, the caller must hold the write lock.
+// The data is copied, so the caller can modify it later.
+func (t *inMemTranslatorStorage) add(lang string, data map[string]string) {
 	if _, ok := t.translations[lang]; !ok {
-		t.translations[lang] = data
+		t.translations[lang] = make(map[string]string, len(data))
 	}
 	for key, value := range data {
 		t.translations[lang][key] = value
 	}
 }
 
+// Merge already existing translations with new.
 func (t *inMemTranslatorStorage) Merge(locales map[string]map[string]string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
 	if t.translations == nil {
 		return
 	}
 	for lang, data := range locales {
-		t.Add(lang, data)
+		if data != nil {
+			t.add(lang, data)
+		}
 	}
 }
 
 // Get returns the translated value for a given format and language preferences.
 func (t *inMemTranslatorStorage) Get(prefer []string, format string, args ...any) string {
 	translatedFormat := format
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	for _, preferLang := range prefer {
 		langFormat, ok := t.translations[preferLang][format]
 		if ok {
@@ -1,6 +1,8 @@
 package translator
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 )
 
@@ -64,3 +66,27 @@ func TestInMemTranslatorStorage_GetTranslated_NoPrefer(t *testing.T) {
 		t.Errorf("expected translated string to be empty, got '%s'", translated)
 	}
 }
+
+func TestInMemTranslatorStorageConcurrent(t *testing.T) {
+	storage := NewInMemStorage()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			lang := fmt.Sprintf("l%d", i)
+			data := map[string]string{"hello": "Hello, %s!"}
+			storage.Add(lang, data)
+			data["hello"] = "changed"
+			storage.Merge(map[string]map[string]string{lang: {"bye": "Bye"}})
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			_ = storage.Get([]string{fmt.Sprintf("l%d", i), "en"}, "hello", "John")
+		}(i)
+	}
+	wg.Wait()
+	if translated := storage.Get([]string{"l0"}, "hello", "John"); translated != "Hello, John!" {
+		t.Errorf("expected translated string to be 'Hello, John!', got '%s'", translated)
+	}
+}
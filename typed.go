@@ -0,0 +1,458 @@
+package valigo
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/insei/valigo/num"
+	"github.com/insei/valigo/shared"
+	"github.com/insei/valigo/str"
+)
+
+const (
+	sliceUniqueLocaleKey    = "validation:slice:Should contain unique values"
+	stringMinLengthLocalKey = "validation:string:Cannot be shorter than %d characters"
+	stringMaxLengthLocalKey = "validation:string:Cannot be longer than %d characters"
+)
+
+// numberBuilder is the NumberBuilder implementation on top of num.BaseConfigurator.
+type numberBuilder[T Number] struct {
+	c num.BaseConfigurator
+	// value returns the typed pointer to the number by the pointer to the field value.
+	value func(v any) *T
+}
+
+// Num returns a NumberBuilder for validating the number field, i.e. valigo.Num(c, &obj.Age).Min(18).
+// The rules parameters and the custom functions values are typed by the field type.
+func Num[T Number, S any](c Configurator[S], fieldPtr *T) NumberBuilder[T] {
+	return &numberBuilder[T]{
+		c: c.Number(fieldPtr),
+		value: func(v any) *T {
+			return v.(*T)
+		},
+	}
+}
+
+// NumPtr returns a NumberBuilder for validating the pointer to number field, i.e. valigo.NumPtr(c, &obj.Age).Min(18).
+// The rules parameters and the custom functions values are typed by the field type.
+func NumPtr[T Number, S any](c Configurator[S], fieldPtr **T) NumberBuilder[T] {
+	return &numberBuilder[T]{
+		c: c.Number(fieldPtr),
+		value: func(v any) *T {
+			return *v.(**T)
+		},
+	}
+}
+
+func (b *numberBuilder[T]) with(c num.BaseConfigurator) NumberBuilder[T] {
+	return &numberBuilder[T]{c: c, value: b.value}
+}
+
+// Default sets the value to the zero or nil pointer number field.
+func (b *numberBuilder[T]) Default(value T) NumberBuilder[T] {
+	b.c.Default(value)
+	return b
+}
+
+// Required checks if the number field is not nil pointer.
+func (b *numberBuilder[T]) Required() NumberBuilder[T] {
+	b.c.Required()
+	return b
+}
+
+// Max checks if the number is not greater than the given maximum number.
+func (b *numberBuilder[T]) Max(maxNum T) NumberBuilder[T] {
+	b.c.Max(maxNum)
+	return b
+}
+
+// Min checks if the number is not less than the given minimum number.
+func (b *numberBuilder[T]) Min(minNum T) NumberBuilder[T] {
+	b.c.Min(minNum)
+	return b
+}
+
+// Gt checks if the number is greater than the given number.
+func (b *numberBuilder[T]) Gt(n T) NumberBuilder[T] {
+	b.c.Gt(n)
+	return b
+}
+
+// Lt checks if the number is less than the given number.
+func (b *numberBuilder[T]) Lt(n T) NumberBuilder[T] {
+	b.c.Lt(n)
+	return b
+}
+
+// AnyOf checks if the number is one of the allowed values.
+func (b *numberBuilder[T]) AnyOf(allowed ...T) NumberBuilder[T] {
+	values := make([]any, 0, len(allowed))
+	for _, v := range allowed {
+		values = append(values, v)
+	}
+	b.c.AnyOf(values...)
+	return b
+}
+
+// AnyOfInterval checks if the number is in the interval (begin, end).
+func (b *numberBuilder[T]) AnyOfInterval(begin, end T) NumberBuilder[T] {
+	b.c.AnyOfInterval(begin, end)
+	return b
+}
+
+// EqField checks if the number is equal to the value of the other field.
+func (b *numberBuilder[T]) EqField(fieldPtr *T) NumberBuilder[T] {
+	b.c.EqField(fieldPtr)
+	return b
+}
+
+// NeField checks if the number is not equal to the value of the other field.
+func (b *numberBuilder[T]) NeField(fieldPtr *T) NumberBuilder[T] {
+	b.c.NeField(fieldPtr)
+	return b
+}
+
+// GtField checks if the number is greater than the value of the other field.
+func (b *numberBuilder[T]) GtField(fieldPtr *T) NumberBuilder[T] {
+	b.c.GtField(fieldPtr)
+	return b
+}
+
+// GteField checks if the number is greater than or equal to the value of the other field.
+func (b *numberBuilder[T]) GteField(fieldPtr *T) NumberBuilder[T] {
+	b.c.GteField(fieldPtr)
+	return b
+}
+
+// LtField checks if the number is less than the value of the other field.
+func (b *numberBuilder[T]) LtField(fieldPtr *T) NumberBuilder[T] {
+	b.c.LtField(fieldPtr)
+	return b
+}
+
+// LteField checks if the number is less than or equal to the value of the other field.
+func (b *numberBuilder[T]) LteField(fieldPtr *T) NumberBuilder[T] {
+	b.c.LteField(fieldPtr)
+	return b
+}
+
+// Custom allows for custom validation logic to be applied to the typed number value.
+func (b *numberBuilder[T]) Custom(fn func(ctx context.Context, h *shared.FieldCustomHelper, value *T) []shared.Error, opts ...shared.CustomOption) NumberBuilder[T] {
+	b.c.Custom(func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error {
+		return fn(ctx, h, b.value(value))
+	}, opts...)
+	return b
+}
+
+// When allows for conditional validation logic to be applied to the typed number value.
+func (b *numberBuilder[T]) When(fn func(ctx context.Context, value *T) bool) NumberBuilder[T] {
+	if fn == nil {
+		return b
+	}
+	return b.with(b.c.When(func(ctx context.Context, value any) bool {
+		return fn(ctx, b.value(value))
+	}))
+}
+
+// Bail stops the evaluation of the following rules at the first failed rule.
+func (b *numberBuilder[T]) Bail() NumberBuilder[T] {
+	return b.with(b.c.Bail())
+}
+
+// numbersBundle is the NumbersBundleBuilder implementation.
+type numbersBundle[S any] struct {
+	c Configurator[S]
+}
+
+// Numbers returns a NumbersBundleBuilder for validating the number fields of the Configurator type,
+// i.e. valigo.Numbers(c).Int(&obj.Age).Min(18).
+func Numbers[S any](c Configurator[S]) NumbersBundleBuilder {
+	return &numbersBundle[S]{c: c}
+}
+
+func (n *numbersBundle[S]) Int(field *int) NumberBuilder[int]              { return Num(n.c, field) }
+func (n *numbersBundle[S]) Int8(field *int8) NumberBuilder[int8]           { return Num(n.c, field) }
+func (n *numbersBundle[S]) Int16(field *int16) NumberBuilder[int16]        { return Num(n.c, field) }
+func (n *numbersBundle[S]) Int32(field *int32) NumberBuilder[int32]        { return Num(n.c, field) }
+func (n *numbersBundle[S]) Int64(field *int64) NumberBuilder[int64]        { return Num(n.c, field) }
+func (n *numbersBundle[S]) IntPtr(field **int) NumberBuilder[int]          { return NumPtr(n.c, field) }
+func (n *numbersBundle[S]) Int8Ptr(field **int8) NumberBuilder[int8]       { return NumPtr(n.c, field) }
+func (n *numbersBundle[S]) Int16Ptr(field **int16) NumberBuilder[int16]    { return NumPtr(n.c, field) }
+func (n *numbersBundle[S]) Int32Ptr(field **int32) NumberBuilder[int32]    { return NumPtr(n.c, field) }
+func (n *numbersBundle[S]) Int64Ptr(field **int64) NumberBuilder[int64]    { return NumPtr(n.c, field) }
+func (n *numbersBundle[S]) Uint(field *uint) NumberBuilder[uint]           { return Num(n.c, field) }
+func (n *numbersBundle[S]) Uint8(field *uint8) NumberBuilder[uint8]        { return Num(n.c, field) }
+func (n *numbersBundle[S]) Uint16(field *uint16) NumberBuilder[uint16]     { return Num(n.c, field) }
+func (n *numbersBundle[S]) Uint32(field *uint32) NumberBuilder[uint32]     { return Num(n.c, field) }
+func (n *numbersBundle[S]) Uint64(field *uint64) NumberBuilder[uint64]     { return Num(n.c, field) }
+func (n *numbersBundle[S]) UintPtr(field **uint) NumberBuilder[uint]       { return NumPtr(n.c, field) }
+func (n *numbersBundle[S]) Uint8Ptr(field **uint8) NumberBuilder[uint8]    { return NumPtr(n.c, field) }
+func (n *numbersBundle[S]) Uint16Ptr(field **uint16) NumberBuilder[uint16] { return NumPtr(n.c, field) }
+func (n *numbersBundle[S]) Uint32Ptr(field **uint32) NumberBuilder[uint32] { return NumPtr(n.c, field) }
+func (n *numbersBundle[S]) Uint64Ptr(field **uint64) NumberBuilder[uint64] { return NumPtr(n.c, field) }
+func (n *numbersBundle[S]) Float32(field *float32) NumberBuilder[float32]  { return Num(n.c, field) }
+func (n *numbersBundle[S]) Float64(field *float64) NumberBuilder[float64]  { return Num(n.c, field) }
+func (n *numbersBundle[S]) Float32Ptr(field **float32) NumberBuilder[float32] {
+	return NumPtr(n.c, field)
+}
+func (n *numbersBundle[S]) Float64Ptr(field **float64) NumberBuilder[float64] {
+	return NumPtr(n.c, field)
+}
+
+// stringBuilder is the StringBuilder implementation on top of str.BaseConfigurator.
+type stringBuilder struct {
+	c str.BaseConfigurator
+	// value returns the pointer to the string by the pointer to the field value.
+	value func(v any) *string
+}
+
+// Str returns a StringBuilder for validating the string field, i.e. valigo.Str(c, &obj.Name).MaxLen(64).
+// The custom functions values are typed by the field type.
+func Str[S any](c Configurator[S], fieldPtr *string) StringBuilder {
+	return &stringBuilder{
+		c: c.String(fieldPtr),
+		value: func(v any) *string {
+			return v.(*string)
+		},
+	}
+}
+
+// StrPtr returns a StringBuilder for validating the pointer to string field, i.e. valigo.StrPtr(c, &obj.Name).MaxLen(64).
+// The custom functions values are typed by the field type.
+func StrPtr[S any](c Configurator[S], fieldPtr **string) StringBuilder {
+	return &stringBuilder{
+		c: c.String(fieldPtr),
+		value: func(v any) *string {
+			return *v.(**string)
+		},
+	}
+}
+
+func (b *stringBuilder) with(c str.BaseConfigurator) StringBuilder {
+	return &stringBuilder{c: c, value: b.value}
+}
+
+// Trim removes leading and trailing whitespace from the string value.
+func (b *stringBuilder) Trim() StringBuilder {
+	b.c.Trim()
+	return b
+}
+
+// Default sets the value to the empty or nil pointer string field.
+func (b *stringBuilder) Default(value string) StringBuilder {
+	b.c.Default(value)
+	return b
+}
+
+// Required checks if the string is not empty.
+func (b *stringBuilder) Required() StringBuilder {
+	b.c.Required()
+	return b
+}
+
+// MaxLen checks if the string length exceeds the maximum allowed length.
+func (b *stringBuilder) MaxLen(maxLen int) StringBuilder {
+	b.c.MaxLen(maxLen)
+	return b
+}
+
+// MinLen checks if the string length is not less than the given minimum length.
+func (b *stringBuilder) MinLen(minLen int) StringBuilder {
+	b.c.MinLen(minLen)
+	return b
+}
+
+// AnyOf checks if the string value is one of the allowed values.
+func (b *stringBuilder) AnyOf(allowed ...string) StringBuilder {
+	b.c.AnyOf(allowed...)
+	return b
+}
+
+// Regexp checks if the string value matches the given regular expression.
+func (b *stringBuilder) Regexp(regexp *regexp.Regexp, opts ...str.RegexpOption) StringBuilder {
+	b.c.Regexp(regexp, opts...)
+	return b
+}
+
+// Email checks is the string value is email.
+func (b *stringBuilder) Email() StringBuilder {
+	b.c.Email()
+	return b
+}
+
+// EqField checks if the string value is equal to the value of the other field.
+func (b *stringBuilder) EqField(fieldPtr *string) StringBuilder {
+	b.c.EqField(fieldPtr)
+	return b
+}
+
+// NeField checks if the string value is not equal to the value of the other field.
+func (b *stringBuilder) NeField(fieldPtr *string) StringBuilder {
+	b.c.NeField(fieldPtr)
+	return b
+}
+
+// Custom allows for custom validation logic to be applied to the typed string value.
+func (b *stringBuilder) Custom(fn func(ctx context.Context, h *shared.FieldCustomHelper, value *string) []shared.Error, opts ...shared.CustomOption) StringBuilder {
+	b.c.Custom(func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error {
+		return fn(ctx, h, b.value(value))
+	}, opts...)
+	return b
+}
+
+// When allows for conditional validation logic to be applied to the typed string value.
+func (b *stringBuilder) When(fn func(ctx context.Context, value *string) bool) StringBuilder {
+	if fn == nil {
+		return b
+	}
+	return b.with(b.c.When(func(ctx context.Context, value any) bool {
+		return fn(ctx, b.value(value))
+	}))
+}
+
+// Bail stops the evaluation of the following rules at the first failed rule.
+func (b *stringBuilder) Bail() StringBuilder {
+	return b.with(b.c.Bail())
+}
+
+// stringSliceBuilder is the StringSliceBuilder implementation on top of str.StringSliceFieldConfigurator.
+type stringSliceBuilder[T string | *string] struct {
+	c *str.StringSliceFieldConfigurator
+}
+
+// StrSlice returns a StringSliceBuilder for validating the slice of strings or pointers to strings field,
+// i.e. valigo.StrSlice(c, &obj.Tags).Max(16).Unique().
+func StrSlice[T string | *string, S any](c Configurator[S], fieldPtr *[]T) StringSliceBuilder[T] {
+	return &stringSliceBuilder[T]{c: c.StringSlice(fieldPtr)}
+}
+
+// StrSlicePtr returns a StringSliceBuilder for validating the pointer to slice of strings or pointers to strings field,
+// i.e. valigo.StrSlicePtr(c, &obj.Tags).Max(16).Unique().
+func StrSlicePtr[T string | *string, S any](c Configurator[S], fieldPtr **[]T) StringSliceBuilder[T] {
+	return &stringSliceBuilder[T]{c: c.StringSlice(fieldPtr)}
+}
+
+func (b *stringSliceBuilder[T]) with(c *shared.SliceFieldConfigurator) StringSliceBuilder[T] {
+	return &stringSliceBuilder[T]{c: &str.StringSliceFieldConfigurator{SliceFieldConfigurator: c}}
+}
+
+// Required checks if the slice is not empty.
+func (b *stringSliceBuilder[T]) Required() StringSliceBuilder[T] {
+	b.c.Required()
+	return b
+}
+
+// Trim removes leading and trailing whitespace from each string in the slice.
+func (b *stringSliceBuilder[T]) Trim() StringSliceBuilder[T] {
+	b.c.Trim()
+	return b
+}
+
+// Max checks if the length of each string in the slice does not exceed the maximum length,
+// error locations are formatted as Field[index].
+func (b *stringSliceBuilder[T]) Max(maxLen uint) StringSliceBuilder[T] {
+	return b.eachString(shared.RuleMaxLen, func(v string) bool {
+		return uint(len(v)) <= maxLen
+	}, stringMaxLengthLocalKey, int(maxLen))
+}
+
+// Min checks if the length of each string in the slice is not less than the minimum length,
+// error locations are formatted as Field[index].
+func (b *stringSliceBuilder[T]) Min(minLen uint) StringSliceBuilder[T] {
+	return b.eachString(shared.RuleMinLen, func(v string) bool {
+		return uint(len(v)) >= minLen
+	}, stringMinLengthLocalKey, int(minLen))
+}
+
+// eachString appends the rule checking each not nil string in the slice, the rule is described with the name.
+func (b *stringSliceBuilder[T]) eachString(name string, validationFn func(v string) bool, localeKey string, args ...any) StringSliceBuilder[T] {
+	b.c.CustomRule(shared.Rule{Name: name, Params: args, Scope: shared.RuleScopeItems, LocaleKey: localeKey, LocaleArgs: args}, func(ctx context.Context, h *shared.FieldCustomHelper, value []*any) []shared.Error {
+		var errs []shared.Error
+		for i, v := range shared.UnsafeValigoSliceCast[string](value) {
+			if v == nil || validationFn(*v) {
+				continue
+			}
+			err := h.ErrorT(ctx, *v, localeKey, args...)
+			err.Location += "[" + strconv.Itoa(i) + "]"
+			errs = append(errs, err)
+		}
+		return errs
+	})
+	return b
+}
+
+// Unique checks if all not nil strings in the slice are unique.
+func (b *stringSliceBuilder[T]) Unique() StringSliceBuilder[T] {
+	b.c.CustomRule(shared.Rule{Name: shared.RuleUnique, LocaleKey: sliceUniqueLocaleKey}, func(ctx context.Context, h *shared.FieldCustomHelper, value []*any) []shared.Error {
+		values := shared.UnsafeValigoSliceCast[string](value)
+		seen := make(map[string]struct{}, len(values))
+		for _, v := range values {
+			if v == nil {
+				continue
+			}
+			if _, ok := seen[*v]; ok {
+				return []shared.Error{h.ErrorT(ctx, *v, sliceUniqueLocaleKey)}
+			}
+			seen[*v] = struct{}{}
+		}
+		return nil
+	})
+	return b
+}
+
+// Email checks if each string in the slice is email.
+func (b *stringSliceBuilder[T]) Email() StringSliceBuilder[T] {
+	b.c.Email()
+	return b
+}
+
+// Custom allows for custom validation logic to be applied to the pointers to the slice strings.
+func (b *stringSliceBuilder[T]) Custom(fn func(ctx context.Context, h *shared.FieldCustomHelper, value []*string) []shared.Error, opts ...shared.CustomOption) StringSliceBuilder[T] {
+	b.c.Custom(func(ctx context.Context, h *shared.FieldCustomHelper, value []*any) []shared.Error {
+		return fn(ctx, h, shared.UnsafeValigoSliceCast[string](value))
+	}, opts...)
+	return b
+}
+
+// When allows for conditional validation logic to be applied to the pointers to the slice strings.
+func (b *stringSliceBuilder[T]) When(fn func(ctx context.Context, value []*string) bool) StringSliceBuilder[T] {
+	if fn == nil {
+		return b
+	}
+	return b.with(b.c.When(func(ctx context.Context, value []*any) bool {
+		return fn(ctx, shared.UnsafeValigoSliceCast[string](value))
+	}))
+}
+
+// Bail stops the evaluation of the following rules at the first failed rule.
+func (b *stringSliceBuilder[T]) Bail() StringSliceBuilder[T] {
+	return &stringSliceBuilder[T]{c: b.c.Bail()}
+}
+
+// slicesBundle is the SlicesBundleBuilder implementation.
+type slicesBundle[S any] struct {
+	c Configurator[S]
+}
+
+// Slices returns a SlicesBundleBuilder for validating the slice fields of the Configurator type,
+// i.e. valigo.Slices(c).SliceStrings(&obj.Tags).Unique().
+func Slices[S any](c Configurator[S]) SlicesBundleBuilder {
+	return &slicesBundle[S]{c: c}
+}
+
+func (s *slicesBundle[S]) SliceStrings(value *[]string) StringSliceBuilder[string] {
+	return StrSlice(s.c, value)
+}
+
+func (s *slicesBundle[S]) SlicePtrStrings(value **[]string) StringSliceBuilder[string] {
+	return StrSlicePtr(s.c, value)
+}
+
+func (s *slicesBundle[S]) SliceStringsPtr(value *[]*string) StringSliceBuilder[*string] {
+	return StrSlice(s.c, value)
+}
+
+func (s *slicesBundle[S]) SlicePtrStringsPtr(value **[]*string) StringSliceBuilder[*string] {
+	return StrSlicePtr(s.c, value)
+}
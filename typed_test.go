@@ -0,0 +1,95 @@
+package valigo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insei/valigo/shared"
+)
+
+type typedUser struct {
+	Name     string
+	Nickname *string
+	Age      int64
+	MinAge   int64
+	Rating   *float32
+	Tags     []string
+	Aliases  *[]*string
+}
+
+func TestTypedBuilders(t *testing.T) {
+	v := New()
+	Configure[typedUser](v, func(c Configurator[typedUser], obj *typedUser) {
+		Str(c, &obj.Name).Trim().Required().Custom(func(ctx context.Context, h *shared.FieldCustomHelper, value *string) []shared.Error {
+			if *value == "admin" {
+				return []shared.Error{h.ErrorT(ctx, *value, "reserved")}
+			}
+			return nil
+		})
+		StrPtr(c, &obj.Nickname).When(func(ctx context.Context, value *string) bool {
+			return value != nil
+		}).MaxLen(3)
+		Num(c, &obj.Age).Min(18).GteField(&obj.MinAge)
+		Numbers(c).Float32Ptr(&obj.Rating).Custom(func(ctx context.Context, h *shared.FieldCustomHelper, value *float32) []shared.Error {
+			if value != nil && *value > 5 {
+				return []shared.Error{h.ErrorT(ctx, *value, "rating")}
+			}
+			return nil
+		})
+		StrSlice(c, &obj.Tags).Bail().Required().Max(3).Unique()
+		Slices(c).SlicePtrStringsPtr(&obj.Aliases).Min(2)
+	})
+
+	rating, alias := float32(4), "ab"
+	validAliases := []*string{&alias, nil}
+	assert.Empty(t, v.ValidateTyped(context.Background(), &typedUser{
+		Name:    " alex ",
+		Age:     20,
+		MinAge:  18,
+		Rating:  &rating,
+		Tags:    []string{"a", "b"},
+		Aliases: &validAliases,
+	}))
+
+	nickname, shortAlias, invalidRating := "alexander", "a", float32(6)
+	aliases := []*string{nil, &shortAlias}
+	errs := v.ValidateTyped(context.Background(), &typedUser{
+		Name:     "admin",
+		Nickname: &nickname,
+		Age:      20,
+		MinAge:   21,
+		Rating:   &invalidRating,
+		Tags:     []string{"a", "long", "a"},
+		Aliases:  &aliases,
+	})
+	var locations []string
+	for _, err := range errs {
+		locations = append(locations, err.Location)
+	}
+	assert.Equal(t, []string{"Name", "Nickname", "Age", "Rating", "Tags[1]", "Aliases[1]"}, locations)
+
+	errs = v.ValidateTyped(context.Background(), &typedUser{Name: "alex", Age: 20, Tags: []string{"a", "a"}, Aliases: &validAliases})
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Should contain unique values", errs[0].Message)
+}
+
+type typedAge int
+
+func TestTypedNamedNumbers(t *testing.T) {
+	type member struct {
+		Age  typedAge
+		Tags []string
+	}
+	v := New()
+	Configure[member](v, func(c Configurator[member], obj *member) {
+		Num(c, &obj.Age).Min(18)
+		StrSlice(c, &obj.Tags).When(func(ctx context.Context, value []*string) bool {
+			return len(value) > 0
+		}).Min(2)
+	})
+	assert.Empty(t, v.ValidateTyped(context.Background(), &member{Age: 18}))
+	errs := v.ValidateTyped(context.Background(), &member{Age: 17, Tags: []string{"a"}})
+	assert.Len(t, errs, 2)
+}
@@ -2,6 +2,7 @@ package valigo
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/insei/valigo/num"
 	"github.com/insei/valigo/shared"
@@ -9,18 +10,50 @@ import (
 	"github.com/insei/valigo/uuid"
 )
 
+// Number is a constraint of the number types supported by the typed number rules.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
 // NumberBuilder is an interface that defines methods for building validators for numeric types.
-type NumberBuilder[T ~int | int8 | int16 | int32 | int64 | *int | *int8 | *int16 | *int32 | *int64 |
-	uint | uint8 | uint16 | uint32 | uint64 | *uint | *uint8 | *uint16 | *uint32 | *uint64 |
-	float64 | float32 | *float64 | *float32] interface {
+// The rules parameters and custom functions values are typed by the field type, see Num and NumPtr.
+type NumberBuilder[T Number] interface {
+	// Default sets the value to the zero or nil pointer number field in the normalization phase.
+	Default(T) NumberBuilder[T]
+	// Required checks if the number field is not nil pointer.
+	Required() NumberBuilder[T]
 	// Max sets a maximum value for the validator.
 	Max(T) NumberBuilder[T]
 	// Min sets a minimum value for the validator.
 	Min(T) NumberBuilder[T]
-	// Custom adds a custom validation function to the validator.
-	Custom(func(ctx context.Context, h *shared.Helper, value *T) []error) NumberBuilder[T]
-	// When sets a condition for when the validator should be applied.
-	When(func(ctx context.Context, value *T) bool) NumberBuilder[T]
+	// Gt checks if the number is greater than the given number.
+	Gt(T) NumberBuilder[T]
+	// Lt checks if the number is less than the given number.
+	Lt(T) NumberBuilder[T]
+	// AnyOf checks if the number is one of the allowed values.
+	AnyOf(allowed ...T) NumberBuilder[T]
+	// AnyOfInterval checks if the number is in the interval (begin, end).
+	AnyOfInterval(begin, end T) NumberBuilder[T]
+	// EqField checks if the number is equal to the value of the other field.
+	EqField(fieldPtr *T) NumberBuilder[T]
+	// NeField checks if the number is not equal to the value of the other field.
+	NeField(fieldPtr *T) NumberBuilder[T]
+	// GtField checks if the number is greater than the value of the other field.
+	GtField(fieldPtr *T) NumberBuilder[T]
+	// GteField checks if the number is greater than or equal to the value of the other field.
+	GteField(fieldPtr *T) NumberBuilder[T]
+	// LtField checks if the number is less than the value of the other field.
+	LtField(fieldPtr *T) NumberBuilder[T]
+	// LteField checks if the number is less than or equal to the value of the other field.
+	LteField(fieldPtr *T) NumberBuilder[T]
+	// Custom adds a custom validation function to the validator, the value is nil for the nil pointer field.
+	Custom(fn func(ctx context.Context, h *shared.FieldCustomHelper, value *T) []shared.Error, opts ...shared.CustomOption) NumberBuilder[T]
+	// When sets a condition for when the validator should be applied, the value is nil for the nil pointer field.
+	When(fn func(ctx context.Context, value *T) bool) NumberBuilder[T]
+	// Bail stops the evaluation of the following rules at the first failed rule.
+	Bail() NumberBuilder[T]
 }
 
 // NumbersBundleBuilder is an interface that defines methods for building validators for numeric types.
@@ -36,15 +69,15 @@ type NumbersBundleBuilder interface {
 	// Int64 returns a NumberBuilder for validating an int64 field.
 	Int64(field *int64) NumberBuilder[int64]
 	// IntPtr returns a NumberBuilder for validating a pointer to an int field.
-	IntPtr(field **int) NumberBuilder[*int]
+	IntPtr(field **int) NumberBuilder[int]
 	// Int8Ptr returns a NumberBuilder for validating a pointer to an int8 field.
-	Int8Ptr(field **int8) NumberBuilder[*int8]
+	Int8Ptr(field **int8) NumberBuilder[int8]
 	// Int16Ptr returns a NumberBuilder for validating a pointer to an int16 field.
-	Int16Ptr(field **int16) NumberBuilder[*int16]
+	Int16Ptr(field **int16) NumberBuilder[int16]
 	// Int32Ptr returns a NumberBuilder for validating a pointer to an int32 field.
-	Int32Ptr(field **int32) NumberBuilder[*int32]
+	Int32Ptr(field **int32) NumberBuilder[int32]
 	// Int64Ptr returns a NumberBuilder for validating a pointer to an int64 field.
-	Int64Ptr(field **int64) NumberBuilder[*int64]
+	Int64Ptr(field **int64) NumberBuilder[int64]
 	// Uint returns a NumberBuilder for validating an uint field.
 	Uint(field *uint) NumberBuilder[uint]
 	// Uint8 returns a NumberBuilder for validating an uint8 field.
@@ -56,20 +89,62 @@ type NumbersBundleBuilder interface {
 	// Uint64 returns a NumberBuilder for validating an uint64 field.
 	Uint64(field *uint64) NumberBuilder[uint64]
 	// UintPtr returns a NumberBuilder for validating a pointer to an uint field.
-	UintPtr(field **uint) NumberBuilder[*uint]
+	UintPtr(field **uint) NumberBuilder[uint]
 	// Uint8Ptr returns a NumberBuilder for validating a pointer to an uint8 field.
-	Uint8Ptr(field **uint8) NumberBuilder[*uint8]
+	Uint8Ptr(field **uint8) NumberBuilder[uint8]
 	// Uint16Ptr returns a NumberBuilder for validating a pointer to an uint16 field.
-	Uint16Ptr(field **uint16) NumberBuilder[*uint16]
+	Uint16Ptr(field **uint16) NumberBuilder[uint16]
 	// Uint32Ptr returns a NumberBuilder for validating a pointer to an uint32 field.
-	Uint32Ptr(field **uint32) NumberBuilder[*uint32]
+	Uint32Ptr(field **uint32) NumberBuilder[uint32]
 	// Uint64Ptr returns a NumberBuilder for validating a pointer to an uint64 field.
-	Uint64Ptr(field **uint64) NumberBuilder[*uint64]
+	Uint64Ptr(field **uint64) NumberBuilder[uint64]
+	// Float32 returns a NumberBuilder for validating a float32 field.
+	Float32(field *float32) NumberBuilder[float32]
+	// Float64 returns a NumberBuilder for validating a float64 field.
+	Float64(field *float64) NumberBuilder[float64]
+	// Float32Ptr returns a NumberBuilder for validating a pointer to a float32 field.
+	Float32Ptr(field **float32) NumberBuilder[float32]
+	// Float64Ptr returns a NumberBuilder for validating a pointer to a float64 field.
+	Float64Ptr(field **float64) NumberBuilder[float64]
+}
+
+// StringBuilder is an interface that defines methods for building validators for strings,
+// the custom functions values are typed, see Str and StrPtr.
+type StringBuilder interface {
+	// Trim removes leading and trailing whitespace from the string in the normalization phase.
+	Trim() StringBuilder
+	// Default sets the value to the empty or nil pointer string field in the normalization phase.
+	Default(string) StringBuilder
+	// Required checks if the string is not empty.
+	Required() StringBuilder
+	// MaxLen sets a maximum length of the string.
+	MaxLen(int) StringBuilder
+	// MinLen sets a minimum length of the string.
+	MinLen(int) StringBuilder
+	// AnyOf checks if the string is one of the allowed values.
+	AnyOf(allowed ...string) StringBuilder
+	// Regexp checks if the string matches the regular expression.
+	Regexp(regexp *regexp.Regexp, opts ...str.RegexpOption) StringBuilder
+	// Email checks if the string is email.
+	Email() StringBuilder
+	// EqField checks if the string is equal to the value of the other field.
+	EqField(fieldPtr *string) StringBuilder
+	// NeField checks if the string is not equal to the value of the other field.
+	NeField(fieldPtr *string) StringBuilder
+	// Custom adds a custom validation function to the validator, the value is nil for the nil pointer field.
+	Custom(fn func(ctx context.Context, h *shared.FieldCustomHelper, value *string) []shared.Error, opts ...shared.CustomOption) StringBuilder
+	// When sets a condition for when the validator should be applied, the value is nil for the nil pointer field.
+	When(fn func(ctx context.Context, value *string) bool) StringBuilder
+	// Bail stops the evaluation of the following rules at the first failed rule.
+	Bail() StringBuilder
 }
 
 // StringSliceBuilder is an interface that defines methods for building validators for string slices.
+// The custom functions values are the pointers to the slice strings, nil strings are skipped.
 type StringSliceBuilder[T string | *string] interface {
-	// Trim removes leading and trailing whitespace from each string in the slice.
+	// Required checks if the slice is not empty.
+	Required() StringSliceBuilder[T]
+	// Trim removes leading and trailing whitespace from each string in the slice in the normalization phase.
 	Trim() StringSliceBuilder[T]
 	// Max sets a maximum length for each string in the slice.
 	Max(uint) StringSliceBuilder[T]
@@ -77,6 +152,14 @@ type StringSliceBuilder[T string | *string] interface {
 	Min(uint) StringSliceBuilder[T]
 	// Unique ensures that all strings in the slice are unique.
 	Unique() StringSliceBuilder[T]
+	// Email checks if each string in the slice is email.
+	Email() StringSliceBuilder[T]
+	// Custom adds a custom validation function to the validator.
+	Custom(fn func(ctx context.Context, h *shared.FieldCustomHelper, value []*string) []shared.Error, opts ...shared.CustomOption) StringSliceBuilder[T]
+	// When sets a condition for when the validator should be applied.
+	When(fn func(ctx context.Context, value []*string) bool) StringSliceBuilder[T]
+	// Bail stops the evaluation of the following rules at the first failed rule.
+	Bail() StringSliceBuilder[T]
 }
 
 // SlicesBundleBuilder is an interface that defines methods for building validators for string slice types.
@@ -103,8 +186,16 @@ type Configurator[T any] interface {
 	UUIDSlice(sliceFieldPtr any) *uuid.UUIDSliceFieldConfigurator
 	// Slice return shared.SliceFieldConfigurator for slice validation
 	Slice(sliceFieldPtr any) *shared.SliceFieldConfigurator
+	// Struct validates the nested struct field with the rules registered for the field type.
+	Struct(structFieldPtr any) *StructFieldConfigurator
+	// StructSlice validates each element of the slice of structs field with the rules registered for the element type.
+	StructSlice(sliceFieldPtr any) *StructSliceFieldConfigurator
+	// Map returns MapFieldConfigurator for map validation
+	Map(mapFieldPtr any) *MapFieldConfigurator
 	// When sets a condition for when the validator should be applied.
 	When(func(ctx context.Context, obj *T) bool) Configurator[T]
+	// Group configures the rules of the validation group, the group is selected with ContextWithGroups.
+	Group(name string, fn func(c Configurator[T]))
 	// Custom adds a custom validation function to the validator.
-	Custom(fn func(ctx context.Context, h shared.StructCustomHelper, obj *T) []shared.Error)
+	Custom(fn func(ctx context.Context, h shared.StructCustomHelper, obj *T) []shared.Error, opts ...shared.CustomOption)
 }
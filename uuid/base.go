@@ -15,15 +15,41 @@ const (
 	anyOfLocaleKey    = "validation:uuid:Only %s values is allowed"
 )
 
+// Sentinel errors of the uuid rules, errors.Is reports whether the validation error is the error of the rule,
+// the errors of the uuid slices elements rules are reported with the same codes.
+var (
+	ErrRequired = shared.NewCodeError(shared.KindUUID, shared.RuleRequired)
+	ErrAnyOf    = shared.NewCodeError(shared.KindUUID, shared.RuleAnyOf)
+)
+
 type baseConfigurator struct {
 	c     *shared.FieldConfigurator[uuid.UUID]
 	field fmap.Field
 	h     shared.Helper
 }
 
+// Default sets the value to the empty uuid field in the normalization phase, before the validation rules,
+// the nil pointer field is set to the pointer to the value.
+func (i *baseConfigurator) Default(value uuid.UUID) BaseConfigurator {
+	i.c.AppendDefault(shared.Rule{Name: shared.RuleDefault, Params: []any{value}}, func() (any, bool) {
+		return value, true
+	})
+	return i
+}
+
+// DefaultFunc sets the value generated by the fn to the empty uuid field in the normalization phase,
+// i.e. uuid.NewV7, the field is left empty if the fn returns the error.
+func (i *baseConfigurator) DefaultFunc(fn func() (uuid.UUID, error)) BaseConfigurator {
+	i.c.AppendDefault(shared.Rule{Name: shared.RuleDefault}, func() (any, bool) {
+		value, err := fn()
+		return value, err == nil
+	})
+	return i
+}
+
 // Required checks if the uuid is not empty.
 func (i *baseConfigurator) Required() BaseConfigurator {
-	i.c.Append(func(v uuid.UUID) bool {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleRequired}, func(v uuid.UUID) bool {
 		return v != uuid.Nil
 	}, requiredLocaleKey)
 
@@ -32,18 +58,18 @@ func (i *baseConfigurator) Required() BaseConfigurator {
 
 // AnyOf checks if the uuid value is one of the allowed values.
 func (i *baseConfigurator) AnyOf(allowed ...uuid.UUID) BaseConfigurator {
-	i.c.Append(func(v uuid.UUID) bool {
+	i.c.AppendRule(shared.Rule{Name: shared.RuleAnyOf, Params: shared.RuleParams(allowed)}, func(v uuid.UUID) bool {
 		return slices.Contains(allowed, v)
 	}, anyOfLocaleKey)
 	return i
 }
 
 // Custom allows for custom validation logic to be applied to the uuid value.
-func (i *baseConfigurator) Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error) BaseConfigurator {
+func (i *baseConfigurator) Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error, opts ...shared.CustomOption) BaseConfigurator {
 	customHelper := shared.NewFieldCustomHelper(i.field, i.h)
 	i.c.CustomAppend(func(ctx context.Context, h shared.Helper, value any) []shared.Error {
 		return f(ctx, customHelper, value)
-	})
+	}, opts...)
 	return i
 }
 
@@ -59,3 +85,12 @@ func (i *baseConfigurator) When(whenFn func(ctx context.Context, value any) bool
 		h:     i.h,
 	}
 }
+
+// Bail stops the evaluation of the following rules for the uuid value at the first failed rule.
+func (i *baseConfigurator) Bail() BaseConfigurator {
+	return &baseConfigurator{
+		c:     i.c.Bail(),
+		field: i.field,
+		h:     i.h,
+	}
+}
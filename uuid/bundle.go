@@ -15,6 +15,8 @@ type UUIDBundle struct {
 	storage  fmap.Storage
 	obj      any
 	h        shared.Helper
+	errFn    shared.ConfigErrorFn
+	describe func(field fmap.Field, r shared.Rule)
 }
 
 // NewUUIDBundle creates a new Bundle instance.
@@ -25,6 +27,8 @@ func NewUUIDBundle(deps shared.BundleDependencies) *UUIDBundle {
 		storage:  deps.Fields,
 		obj:      deps.Object,
 		h:        deps.Helper,
+		errFn:    deps.ErrorFn,
+		describe: deps.DescribeFn,
 	}
 }
 
@@ -32,6 +36,8 @@ type baseConfiguratorParams struct {
 	Field    fmap.Field
 	Helper   shared.Helper
 	AppendFn func(fn shared.FieldValidationFn)
+	// DescribeFn receives the descriptors of the rules, it is optional.
+	DescribeFn shared.DescribeFn
 }
 
 func newBaseConfigurator(p baseConfiguratorParams, derefFn func(value any) (uuid.UUID, bool)) *baseConfigurator {
@@ -47,8 +53,10 @@ func newBaseConfigurator(p baseConfiguratorParams, derefFn func(value any) (uuid
 		field: p.Field,
 		h:     p.Helper,
 		c: shared.NewFieldConfigurator[uuid.UUID](shared.FieldConfiguratorParams[uuid.UUID]{
-			Maker:    mk,
-			AppendFn: p.AppendFn,
+			Maker:      mk,
+			AppendFn:   p.AppendFn,
+			DescribeFn: p.DescribeFn,
+			Kind:       shared.KindUUID,
 		}),
 	}
 }
@@ -71,8 +79,14 @@ func deref(value any) (uuid.UUID, bool) {
 
 // UUID returns a FieldConfigurator instance for an uuid field.
 // It takes a pointer to an uuid field as an argument.
+// The configuration error is reported for the unknown field or not an uuid field,
+// the rules of such field are discarded.
 func (i *UUIDBundle) UUID(fieldPtr any) BaseConfigurator {
 	field, err := i.storage.GetFieldByPtr(i.obj, fieldPtr)
+	if err != nil {
+		i.errFn.Report(shared.NewConfigError(nil, "uuid field: %v", err))
+		return newDiscardConfigurator(field, i.h)
+	}
 
 	var derefFn func(value any) (uuid.UUID, bool)
 	switch reflect.PointerTo(field.GetType()) {
@@ -80,10 +94,9 @@ func (i *UUIDBundle) UUID(fieldPtr any) BaseConfigurator {
 		derefFn = deref
 	case reflect.TypeOf(new(*uuid.UUID)):
 		derefFn = ptrDeref
-	}
-
-	if err != nil {
-		panic(err)
+	default:
+		i.errFn.Report(shared.NewConfigError(field, "type %s is not an uuid or pointer to uuid", field.GetType().String()))
+		return newDiscardConfigurator(field, i.h)
 	}
 	return newBaseConfigurator(baseConfiguratorParams{
 		Field:  field,
@@ -91,5 +104,16 @@ func (i *UUIDBundle) UUID(fieldPtr any) BaseConfigurator {
 		AppendFn: func(fn shared.FieldValidationFn) {
 			i.appendFn(field, fn)
 		},
+		DescribeFn: shared.NewFieldDescribeFn(field, i.describe),
 	}, derefFn)
 }
+
+// newDiscardConfigurator returns a BaseConfigurator instance of the invalid field configuration,
+// it discards the rules.
+func newDiscardConfigurator(field fmap.Field, h shared.Helper) BaseConfigurator {
+	return newBaseConfigurator(baseConfiguratorParams{
+		Field:    field,
+		Helper:   h,
+		AppendFn: shared.DiscardFieldValidationFn,
+	}, deref)
+}
@@ -12,13 +12,21 @@ type UUIDSliceFieldConfigurator struct {
 }
 
 func NewUUIDSliceFieldConfigurator(p shared.SliceFieldConfiguratorParams) *UUIDSliceFieldConfigurator {
+	p.ItemsKind = shared.KindUUID
 	return &UUIDSliceFieldConfigurator{
 		shared.NewSliceFieldConfigurator(p),
 	}
 }
 
 func (s *UUIDSliceFieldConfigurator) AnyOf(allowed ...uuid.UUID) *UUIDSliceFieldConfigurator {
-	s.Custom(func(ctx context.Context, h *shared.FieldCustomHelper, v []*any) []shared.Error {
+	rule := shared.Rule{
+		Name:       shared.RuleAnyOf,
+		Params:     shared.RuleParams(allowed),
+		Scope:      shared.RuleScopeItems,
+		LocaleKey:  anyOfLocaleKey,
+		LocaleArgs: []any{allowed},
+	}
+	s.CustomRule(rule, func(ctx context.Context, h *shared.FieldCustomHelper, v []*any) []shared.Error {
 		values := shared.UnsafeValigoSliceCast[uuid.UUID](v)
 		var errs []shared.Error
 		for _, val := range values {
@@ -30,3 +38,10 @@ func (s *UUIDSliceFieldConfigurator) AnyOf(allowed ...uuid.UUID) *UUIDSliceField
 	})
 	return s
 }
+
+// Bail stops the evaluation of the following rules at the first failed rule.
+func (s *UUIDSliceFieldConfigurator) Bail() *UUIDSliceFieldConfigurator {
+	return &UUIDSliceFieldConfigurator{
+		s.SliceFieldConfigurator.Bail(),
+	}
+}
@@ -8,6 +8,10 @@ import (
 )
 
 type BaseConfigurator interface {
+	// Default sets the value to the empty uuid.UUID field in the normalization phase.
+	Default(value uuid.UUID) BaseConfigurator
+	// DefaultFunc sets the value generated by the fn to the empty uuid.UUID field in the normalization phase.
+	DefaultFunc(fn func() (uuid.UUID, error)) BaseConfigurator
 	// Required checks if the uuid.UUID value is not empty.
 	Required() BaseConfigurator
 
@@ -15,10 +19,13 @@ type BaseConfigurator interface {
 	AnyOf(allowed ...uuid.UUID) BaseConfigurator
 
 	// Custom allows for custom validation logic.
-	Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error) BaseConfigurator
+	Custom(f func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error, opts ...shared.CustomOption) BaseConfigurator
 
 	// When allows for conditional validation based on a given condition.
 	When(whenFn func(ctx context.Context, value any) bool) BaseConfigurator
+
+	// Bail stops the evaluation of the following rules at the first failed rule.
+	Bail() BaseConfigurator
 }
 
 // UUIDBundleConfigurator is a builder interface for a bundle of uuid fields.
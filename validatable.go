@@ -0,0 +1,159 @@
+package valigo
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/insei/fmap/v3"
+
+	"github.com/insei/valigo/shared"
+)
+
+// Validatable is implemented by the self-validating types owning their invariants.
+// The Validate method is called by the Validator for the validated object and for the values
+// of the nested struct, pointer to struct and slice of structs fields, without any Configure call.
+// The returned errors are merged with the errors of the configured rules, their locations
+// are relative to the object and prefixed with the location of the nested field.
+// The method is not called by the partial validation, the same way as struct level Custom rules.
+type Validatable interface {
+	Validate(ctx context.Context) []shared.Error
+}
+
+// ValidatableWithHelper is similar to Validatable, but the Validate method receives
+// the shared.StructCustomHelper to create the localized errors of the object fields,
+// the fields are passed by pointers, so the method should have a pointer receiver.
+type ValidatableWithHelper interface {
+	Validate(ctx context.Context, h shared.StructCustomHelper) []shared.Error
+}
+
+var (
+	validatableType           = reflect.TypeOf((*Validatable)(nil)).Elem()
+	validatableWithHelperType = reflect.TypeOf((*ValidatableWithHelper)(nil)).Elem()
+	// validatableFields is a cache of the nested fields containing the self-validating values for each type.
+	validatableFields sync.Map
+)
+
+// validateSelf calls the Validate method of the obj if it implements Validatable or ValidatableWithHelper.
+func (v *Validator) validateSelf(ctx context.Context, obj any) []shared.Error {
+	switch o := obj.(type) {
+	case Validatable:
+		return o.Validate(ctx)
+	case ValidatableWithHelper:
+		fields, err := getFields(obj)
+		if err != nil {
+			return nil
+		}
+		return o.Validate(ctx, newStructCustomHelper(fields, obj, v.helper))
+	}
+	return nil
+}
+
+// validateValidatableNested calls the Validate methods of the self-validating values of the nested fields
+// of the obj, the fields configured explicitly with Configurator.Struct or Configurator.StructSlice
+// are skipped if skipExplicit is true, they are validated by their rules.
+func (v *Validator) validateValidatableNested(ctx context.Context, obj any, skipExplicit bool) []shared.Error {
+	var errs []shared.Error
+	t := reflect.TypeOf(obj)
+	sel := selectionFromContext(ctx)
+	for _, field := range getValidatableFields(t) {
+		if skipExplicit && v.storage.isExplicitNested(t, field.GetStructPath()) {
+			continue
+		}
+		ctx := ctx
+		if sel != nil {
+			ok, nested := sel.match(field.GetStructPath())
+			if !ok {
+				continue
+			}
+			ctx = withSelection(ctx, nested)
+		}
+		location := v.helper.getFieldLocation(field)
+		if isStructSlice(field.GetType()) {
+			errs = append(errs, forEachNested(ctx, field.GetPtr(obj), location, v.validateValidatable)...)
+			continue
+		}
+		nested, ok := derefStruct(field.GetPtr(obj))
+		if !ok {
+			continue
+		}
+		errs = append(errs, v.validateValidatable(ctx, nested, location)...)
+	}
+	return errs
+}
+
+// validateValidatable calls the Validate methods of the self-validating nested obj and its nested fields values,
+// error locations are prefixed with the prefix.
+func (v *Validator) validateValidatable(ctx context.Context, obj any, prefix string) []shared.Error {
+	var errs []shared.Error
+	if selectionFromContext(ctx) == nil {
+		errs = v.validateSelf(ctx, obj)
+	}
+	errs = append(errs, v.validateValidatableNested(ctx, obj, false)...)
+	for i := range errs {
+		errs[i].Location = joinLocation(prefix, errs[i].Location)
+	}
+	return errs
+}
+
+// getValidatableFields returns the top level exported struct, pointer to struct and slice of structs fields
+// of the pointer to struct type t, the values of which implement Validatable or ValidatableWithHelper
+// or contain such nested values.
+func getValidatableFields(t reflect.Type) []fmap.Field {
+	if fields, ok := validatableFields.Load(t); ok {
+		return fields.([]fmap.Field)
+	}
+	var nested []fmap.Field
+	if t != nil && t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct {
+		fields, err := getFields(reflect.New(t.Elem()).Interface())
+		if err == nil {
+			visited := map[reflect.Type]bool{t: false}
+			for _, path := range fields.GetAllPaths() {
+				field := fields.MustFind(path)
+				if strings.Contains(path, ".") || !field.IsExported() {
+					continue
+				}
+				if field.GetDereferencedType().Kind() != reflect.Struct && !isStructSlice(field.GetType()) {
+					continue
+				}
+				if nestedType, ok := getNestedStructType(field.GetType()); ok && isValidatable(nestedType, visited) {
+					nested = append(nested, field)
+				}
+			}
+		}
+	}
+	validatableFields.Store(t, nested)
+	return nested
+}
+
+// isValidatable checks if the pointer to struct type t implements Validatable or ValidatableWithHelper,
+// or the struct contains the exported fields of such types. The visited contains the checked types results,
+// the types being checked are false to break the recursion.
+func isValidatable(t reflect.Type, visited map[reflect.Type]bool) bool {
+	if t.Implements(validatableType) || t.Implements(validatableWithHelperType) {
+		return true
+	}
+	if res, ok := visited[t]; ok {
+		return res
+	}
+	visited[t] = false
+	for i := 0; i < t.Elem().NumField(); i++ {
+		f := t.Elem().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if ft.Kind() != reflect.Struct && !isStructSlice(f.Type) {
+			continue
+		}
+		if nestedType, ok := getNestedStructType(f.Type); ok && isValidatable(nestedType, visited) {
+			visited[t] = true
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package valigo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/insei/valigo/shared"
+)
+
+type testMoney struct {
+	Amount   int
+	Currency string
+}
+
+func (m *testMoney) Validate(ctx context.Context) []shared.Error {
+	if m.Amount != 0 && m.Currency == "" {
+		return []shared.Error{{Location: "Currency", Message: "Should be set for the amount"}}
+	}
+	return nil
+}
+
+type testPeriod struct {
+	From int
+	To   int
+}
+
+func (p *testPeriod) Validate(ctx context.Context, h shared.StructCustomHelper) []shared.Error {
+	if p.From > p.To {
+		return []shared.Error{h.ErrorT(ctx, &p.From, p.From, "Should be before %s", "To")}
+	}
+	return nil
+}
+
+type testItem struct {
+	Price testMoney
+}
+
+type testOrder struct {
+	Name   string
+	Total  testMoney
+	Prices []*testMoney
+	Period *testPeriod
+	Items  []testItem
+	Other  struct{ Name string }
+}
+
+func (o *testOrder) Validate(ctx context.Context) []shared.Error {
+	if o.Name == "invalid" {
+		return []shared.Error{{Location: "Name", Message: "Should be valid"}}
+	}
+	return nil
+}
+
+func TestValidatable(t *testing.T) {
+	order := &testOrder{
+		Name:   "invalid",
+		Total:  testMoney{Amount: 10},
+		Prices: []*testMoney{nil, {Amount: 1, Currency: "EUR"}, {Amount: 1}},
+		Period: &testPeriod{From: 2, To: 1},
+		Items:  []testItem{{Price: testMoney{Amount: 1}}},
+	}
+	expected := []shared.Error{
+		{Location: "Name", Message: "Cannot be longer than 0 characters"},
+		{Location: "Name", Message: "Should be valid"},
+		{Location: "Total.Currency", Message: "Should be set for the amount"},
+		{Location: "Prices[2].Currency", Message: "Should be set for the amount"},
+		{Location: "Period.From", Message: "Should be before To"},
+		{Location: "Items[0].Price.Currency", Message: "Should be set for the amount"},
+	}
+	for _, opts := range [][]Option{nil, {WithAutoNestedValidation()}} {
+		v := New(opts...)
+		Configure[testOrder](v, func(c Configurator[testOrder], obj *testOrder) {
+			c.String(&obj.Name).MaxLen(0)
+		})
+		errs := v.ValidateTyped(context.Background(), order)
+		if len(errs) != len(expected) {
+			t.Fatalf("expected %d errors, got %d: %v", len(expected), len(errs), errs)
+		}
+		for i, err := range errs {
+			if err.Location != expected[i].Location || err.Message != expected[i].Message {
+				t.Errorf("expected %v, got %v", expected[i], err)
+			}
+		}
+	}
+
+	// the explicitly configured nested field is validated once
+	v := New()
+	Configure[testOrder](v, func(c Configurator[testOrder], obj *testOrder) {
+		c.Struct(&obj.Total)
+	})
+	errs := v.ValidateTyped(context.Background(), &testOrder{Total: testMoney{Amount: 10}})
+	if len(errs) != 1 || errs[0].Location != "Total.Currency" {
+		t.Errorf("expected Total.Currency error, got %v", errs)
+	}
+
+	// the self-validating type is validated without configuration
+	errs = New().ValidateTyped(context.Background(), &testMoney{Amount: 10})
+	if len(errs) != 1 || errs[0].Location != "Currency" {
+		t.Errorf("expected Currency error, got %v", errs)
+	}
+
+	// the partial validation skips the object level method, but calls the methods of the selected fields
+	errs = New().ValidatePartialTyped(context.Background(), order, &order.Total)
+	if len(errs) != 1 || errs[0].Location != "Total.Currency" {
+		t.Errorf("expected Total.Currency error, got %v", errs)
+	}
+}
@@ -0,0 +1,560 @@
+package valigo
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/insei/valigo/num"
+	"github.com/insei/valigo/shared"
+	"github.com/insei/valigo/str"
+)
+
+const (
+	// validateTagKey is the key of the go-playground/validator compatible validation tag,
+	// i.e. `validate:"required,gte=0,lte=130"`.
+	validateTagKey = "validate"
+
+	numRequiredLocaleKey = "validation:num:Should be fulfilled"
+	uuidStringLocaleKey  = "validation:string:Should be UUID"
+)
+
+var uuidStringRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// validateDialect is the go-playground/validator compatible validation tags dialect,
+// i.e. `validate:"required,gte=0,lte=130,oneof=a b"`.
+var validateDialect = &tagDialect{
+	key:            validateTagKey,
+	configureField: configureValidateTagField,
+}
+
+// unsupportedRule returns the configuration error of the rule not supported by the validate tags dialect.
+func (f tagField) unsupportedRule(r tagRule) error {
+	return f.errorf("unsupported rule %q for type %s", r.name, f.field.GetType().String())
+}
+
+// lengthLimits is the limits of the length rule.
+type lengthLimits struct {
+	min, max       int
+	hasMin, hasMax bool
+}
+
+// lengthLimits parses the length (strings, slices) or entries count (maps) rule to the limits.
+func (f tagField) lengthLimits(r tagRule) (lengthLimits, error) {
+	n, err := f.intParam(r)
+	if err != nil {
+		return lengthLimits{}, err
+	}
+	switch r.name {
+	case "min", "gte":
+		return lengthLimits{min: n, hasMin: true}, nil
+	case "max", "lte":
+		return lengthLimits{max: n, hasMax: true}, nil
+	case "gt":
+		return lengthLimits{min: n + 1, hasMin: true}, nil
+	case "lt":
+		return lengthLimits{max: n - 1, hasMax: true}, nil
+	}
+	return lengthLimits{min: n, max: n, hasMin: true, hasMax: true}, nil
+}
+
+// isLengthRule checks if the rule limits the length of the value.
+func isLengthRule(name string) bool {
+	switch name {
+	case "min", "max", "len", "gte", "lte", "gt", "lt":
+		return true
+	}
+	return false
+}
+
+// isNotEmptyValue checks if the value pointed by the pointer is not the zero value.
+func isNotEmptyValue(_ context.Context, value any) bool {
+	return !reflect.ValueOf(value).Elem().IsZero()
+}
+
+// requireNotZero is the custom number rule checking that the number is not zero.
+func requireNotZero(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error {
+	v := reflect.ValueOf(value).Elem()
+	if v.IsZero() {
+		err := h.ErrorT(ctx, v.Interface(), numRequiredLocaleKey)
+		return []shared.Error{err.WithCode(string(num.ErrRequired))}
+	}
+	return nil
+}
+
+// configureValidateTagField returns the function configuring the field rules from the validate tag rules.
+// The rules after dive are applied to each slice element or map value, omitempty skips the following rules
+// for the empty value. The rules of the field, element or map value are evaluated until the first failed rule.
+func configureValidateTagField(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	var (
+		fieldRules, elemRules []tagRule
+		dive                  bool
+	)
+	for _, r := range rules {
+		switch {
+		case strings.Contains(r.name, "|"):
+			return nil, f.errorf("unsupported rules alternative %q", r.name)
+		case dive && r.name == "dive":
+			return nil, f.errorf("nested dive is not supported")
+		case dive:
+			elemRules = append(elemRules, r)
+		case r.name == "dive":
+			dive = true
+		default:
+			fieldRules = append(fieldRules, r)
+		}
+	}
+	kind := getTagFieldKind(f.field.GetType())
+	switch kind {
+	case tagKindString, tagKindNumber, tagKindUUID, tagKindStruct:
+		if dive {
+			return nil, f.unsupportedRule(tagRule{name: "dive"})
+		}
+	}
+	switch kind {
+	case tagKindString:
+		return validateStringRules(f, fieldRules)
+	case tagKindNumber:
+		return validateNumberRules(f, fieldRules)
+	case tagKindUUID:
+		return validateUUIDRules(f, fieldRules)
+	case tagKindStringSlice, tagKindUUIDSlice, tagKindSlice, tagKindStructSlice:
+		return validateSliceRules(f, fieldRules, elemRules)
+	case tagKindStruct:
+		return validateStructRules(f, fieldRules)
+	case tagKindMap:
+		return validateMapRules(f, fieldRules, dive, elemRules)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return nil, f.errorf("validation tags are not supported for type %s", f.field.GetType().String())
+}
+
+// validateStringSteps returns the string rules from the validate tag rules,
+// each rule returns the configurator for the following rules.
+// The elements rules do not support the cross-field rules.
+func validateStringSteps(f tagField, rules []tagRule, elements bool) ([]func(c str.BaseConfigurator) str.BaseConfigurator, error) {
+	var steps []func(c str.BaseConfigurator) str.BaseConfigurator
+	for _, r := range rules {
+		switch {
+		case r.name == "omitempty":
+			steps = append(steps, func(c str.BaseConfigurator) str.BaseConfigurator { return c.When(isNotEmptyValue) })
+		case r.name == "required":
+			steps = append(steps, func(c str.BaseConfigurator) str.BaseConfigurator { return c.Required() })
+		case r.name == "email":
+			steps = append(steps, func(c str.BaseConfigurator) str.BaseConfigurator { return c.Email() })
+		case r.name == "uuid":
+			steps = append(steps, func(c str.BaseConfigurator) str.BaseConfigurator {
+				return c.Regexp(uuidStringRegexp, str.WithRegexpLocaleKey(uuidStringLocaleKey))
+			})
+		case r.name == "oneof":
+			allowed := strings.Fields(r.param)
+			steps = append(steps, func(c str.BaseConfigurator) str.BaseConfigurator { return c.AnyOf(allowed...) })
+		case isLengthRule(r.name):
+			limits, err := f.lengthLimits(r)
+			if err != nil {
+				return nil, err
+			}
+			if limits.hasMin {
+				steps = append(steps, func(c str.BaseConfigurator) str.BaseConfigurator { return c.MinRunes(limits.min) })
+			}
+			if limits.hasMax {
+				steps = append(steps, func(c str.BaseConfigurator) str.BaseConfigurator { return c.MaxRunes(limits.max) })
+			}
+		case (r.name == "eqfield" || r.name == "nefield") && !elements:
+			ptr, err := f.otherFieldPtr(r)
+			if err != nil {
+				return nil, err
+			}
+			if r.name == "eqfield" {
+				steps = append(steps, func(c str.BaseConfigurator) str.BaseConfigurator { return c.EqField(ptr) })
+			} else {
+				steps = append(steps, func(c str.BaseConfigurator) str.BaseConfigurator { return c.NeField(ptr) })
+			}
+		default:
+			return nil, f.unsupportedRule(r)
+		}
+	}
+	return steps, nil
+}
+
+// validateStringRules returns the function configuring the string field rules.
+func validateStringRules(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	steps, err := validateStringSteps(f, rules, false)
+	if err != nil || len(steps) == 0 {
+		return nil, err
+	}
+	return func(c tagConfigurator) {
+		sc := c.String(f.ptr).Bail()
+		for _, step := range steps {
+			sc = step(sc)
+		}
+	}, nil
+}
+
+// validateNumberSteps returns the number rules from the validate tag rules for the number type t,
+// each rule returns the configurator for the following rules.
+// The required rule checks the number is not zero, or the pointer is not nil for the pointer types.
+// The elements rules do not support the cross-field rules.
+func validateNumberSteps(f tagField, t reflect.Type, rules []tagRule, elements bool) ([]func(c num.BaseConfigurator) num.BaseConfigurator, error) {
+	valueType := t
+	if valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+	var steps []func(c num.BaseConfigurator) num.BaseConfigurator
+	for _, r := range rules {
+		switch r.name {
+		case "omitempty":
+			steps = append(steps, func(c num.BaseConfigurator) num.BaseConfigurator { return c.When(isNotEmptyValue) })
+		case "required":
+			if t.Kind() == reflect.Ptr {
+				steps = append(steps, func(c num.BaseConfigurator) num.BaseConfigurator { return c.Required() })
+			} else {
+				steps = append(steps, func(c num.BaseConfigurator) num.BaseConfigurator { return c.Custom(requireNotZero) })
+			}
+		case "min", "max", "len", "gte", "lte", "gt", "lt":
+			n, err := f.numberParamOf(valueType, r)
+			if err != nil {
+				return nil, err
+			}
+			name := r.name
+			steps = append(steps, func(c num.BaseConfigurator) num.BaseConfigurator {
+				switch name {
+				case "min", "gte":
+					return c.Min(n)
+				case "max", "lte":
+					return c.Max(n)
+				case "gt":
+					return c.Gt(n)
+				case "lt":
+					return c.Lt(n)
+				}
+				return c.AnyOf(n)
+			})
+		case "oneof":
+			allowed, err := f.numberParamsOf(valueType, r)
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, func(c num.BaseConfigurator) num.BaseConfigurator { return c.AnyOf(allowed...) })
+		case "eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield":
+			if elements {
+				return nil, f.unsupportedRule(r)
+			}
+			ptr, err := f.otherFieldPtr(r)
+			if err != nil {
+				return nil, err
+			}
+			name := r.name
+			steps = append(steps, func(c num.BaseConfigurator) num.BaseConfigurator {
+				switch name {
+				case "eqfield":
+					return c.EqField(ptr)
+				case "nefield":
+					return c.NeField(ptr)
+				case "gtfield":
+					return c.GtField(ptr)
+				case "gtefield":
+					return c.GteField(ptr)
+				case "ltfield":
+					return c.LtField(ptr)
+				}
+				return c.LteField(ptr)
+			})
+		default:
+			return nil, f.unsupportedRule(r)
+		}
+	}
+	return steps, nil
+}
+
+// validateNumberRules returns the function configuring the number field rules.
+func validateNumberRules(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	steps, err := validateNumberSteps(f, f.field.GetType(), rules, false)
+	if err != nil || len(steps) == 0 {
+		return nil, err
+	}
+	return func(c tagConfigurator) {
+		nc := c.Number(f.ptr).Bail()
+		for _, step := range steps {
+			nc = step(nc)
+		}
+	}, nil
+}
+
+// validateUUIDRules returns the function configuring the uuid field rules,
+// the uuid rule is always valid for the uuid.UUID type.
+func validateUUIDRules(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	required, omitEmpty := false, false
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			required = true
+		case "omitempty":
+			omitEmpty = !required
+		case "uuid":
+		default:
+			return nil, f.unsupportedRule(r)
+		}
+	}
+	if !required || omitEmpty {
+		return nil, nil
+	}
+	return func(c tagConfigurator) {
+		c.UUID(f.ptr).Required()
+	}, nil
+}
+
+// validateElementRules returns the function configuring the rules applied to each element of the type elemType,
+// the elements of strings and numbers are supported.
+func validateElementRules(f tagField, elemType reflect.Type, rules []tagRule) (stringFn func(c str.BaseConfigurator), numberFn func(c num.BaseConfigurator), err error) {
+	switch getTagFieldKind(elemType) {
+	case tagKindString:
+		steps, err := validateStringSteps(f, rules, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		return func(c str.BaseConfigurator) {
+			c = c.Bail()
+			for _, step := range steps {
+				c = step(c)
+			}
+		}, nil, nil
+	case tagKindNumber:
+		steps, err := validateNumberSteps(f, elemType, rules, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, func(c num.BaseConfigurator) {
+			c = c.Bail()
+			for _, step := range steps {
+				c = step(c)
+			}
+		}, nil
+	}
+	return nil, nil, f.errorf("rules after dive are not supported for elements of type %s", elemType.String())
+}
+
+// validateSliceRules returns the function configuring the slice field rules and the elements rules,
+// each element of the slice of structs is validated with the rules of the element type.
+// The omitempty rule skips the slice rules for the nil slice.
+func validateSliceRules(f tagField, rules, elemRules []tagRule) (func(c tagConfigurator), error) {
+	kind := getTagFieldKind(f.field.GetType())
+	var (
+		steps     []func(c *shared.SliceFieldConfigurator) *shared.SliceFieldConfigurator
+		omitEmpty bool
+	)
+	for _, r := range rules {
+		switch {
+		case r.name == "omitempty":
+			omitEmpty = true
+		case r.name == "required":
+			steps = append(steps, func(c *shared.SliceFieldConfigurator) *shared.SliceFieldConfigurator { return c.Required() })
+		case isLengthRule(r.name):
+			limits, err := f.lengthLimits(r)
+			if err != nil {
+				return nil, err
+			}
+			if limits.hasMin {
+				steps = append(steps, func(c *shared.SliceFieldConfigurator) *shared.SliceFieldConfigurator {
+					return c.MinLen(limits.min)
+				})
+			}
+			if limits.hasMax {
+				steps = append(steps, func(c *shared.SliceFieldConfigurator) *shared.SliceFieldConfigurator {
+					return c.MaxLen(limits.max)
+				})
+			}
+		default:
+			return nil, f.unsupportedRule(r)
+		}
+	}
+	var (
+		stringFn         func(c str.BaseConfigurator)
+		numberFn         func(c num.BaseConfigurator)
+		requiredElements bool
+	)
+	if len(elemRules) > 0 {
+		var err error
+		if kind == tagKindStructSlice {
+			requiredElements, err = validateStructElementRules(f, f.field.GetDereferencedType().Elem(), elemRules)
+		} else {
+			stringFn, numberFn, err = validateElementRules(f, f.field.GetDereferencedType().Elem(), elemRules)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(steps) == 0 && stringFn == nil && numberFn == nil && kind != tagKindStructSlice {
+		return nil, nil
+	}
+	return func(c tagConfigurator) {
+		if omitEmpty {
+			c = c.whenFieldNotEmpty(f.field)
+		}
+		if len(steps) > 0 || kind == tagKindStructSlice {
+			var sc *shared.SliceFieldConfigurator
+			switch kind {
+			case tagKindStringSlice:
+				sc = c.StringSlice(f.ptr).SliceFieldConfigurator
+			case tagKindUUIDSlice:
+				sc = c.UUIDSlice(f.ptr).SliceFieldConfigurator
+			case tagKindStructSlice:
+				sc = c.StructSlice(f.ptr).SliceFieldConfigurator
+			default:
+				sc = c.Slice(f.ptr)
+			}
+			sc = sc.Bail()
+			for _, step := range steps {
+				sc = step(sc)
+			}
+		}
+		switch {
+		case stringFn != nil:
+			stringFn(c.sliceElements(f.ptr).strings())
+		case numberFn != nil:
+			numberFn(c.sliceElements(f.ptr).numbers())
+		case requiredElements:
+			c.sliceElements(f.ptr).requiredStructs()
+		}
+	}, nil
+}
+
+// validateStructElementRules checks the rules after dive of the slice of structs, the elements are validated
+// with the rules of the element type. It returns true if the pointers to struct elements are required,
+// omitempty is allowed and the required rule of the struct (not pointer) elements is always satisfied.
+func validateStructElementRules(f tagField, elemType reflect.Type, rules []tagRule) (bool, error) {
+	var required bool
+	for _, r := range rules {
+		switch r.name {
+		case "omitempty":
+		case "required":
+			required = elemType.Kind() == reflect.Ptr
+		default:
+			return false, f.errorf("unsupported rule %q after dive for elements of type %s", r.name, elemType.String())
+		}
+	}
+	return required, nil
+}
+
+// validateStructRules returns the function configuring the nested struct field rules,
+// the nested struct is validated with the rules of its type.
+// The required rule is checked for the pointers to struct only, omitempty skips the nested struct validation
+// for the zero value.
+func validateStructRules(f tagField, rules []tagRule) (func(c tagConfigurator), error) {
+	var (
+		ptrRules  []tagRule
+		omitEmpty bool
+	)
+	for _, r := range rules {
+		switch {
+		case r.name == "omitempty":
+			omitEmpty = true
+		case r.name != "required":
+			return nil, f.unsupportedRule(r)
+		case f.field.GetType().Kind() == reflect.Ptr:
+			ptrRules = append(ptrRules, r)
+		}
+	}
+	fn, err := valigoStructRules(f, ptrRules)
+	if err != nil || fn == nil || !omitEmpty {
+		return fn, err
+	}
+	return func(c tagConfigurator) {
+		fn(c.whenFieldNotEmpty(f.field))
+	}, nil
+}
+
+// validateMapRules returns the function configuring the map field rules and the map values rules,
+// the map values of structs are validated with the rules of the value type after dive.
+func validateMapRules(f tagField, rules []tagRule, dive bool, elemRules []tagRule) (func(c tagConfigurator), error) {
+	var steps []func(c *shared.MapFieldConfigurator) *shared.MapFieldConfigurator
+	for _, r := range rules {
+		switch {
+		case r.name == "omitempty":
+			steps = append(steps, func(c *shared.MapFieldConfigurator) *shared.MapFieldConfigurator {
+				return c.When(isNotEmptyValue)
+			})
+		case r.name == "required":
+			steps = append(steps, func(c *shared.MapFieldConfigurator) *shared.MapFieldConfigurator { return c.Required() })
+		case isLengthRule(r.name):
+			limits, err := f.lengthLimits(r)
+			if err != nil {
+				return nil, err
+			}
+			if limits.hasMin {
+				steps = append(steps, func(c *shared.MapFieldConfigurator) *shared.MapFieldConfigurator {
+					return c.MinEntries(limits.min)
+				})
+			}
+			if limits.hasMax {
+				steps = append(steps, func(c *shared.MapFieldConfigurator) *shared.MapFieldConfigurator {
+					return c.MaxEntries(limits.max)
+				})
+			}
+		default:
+			return nil, f.unsupportedRule(r)
+		}
+	}
+	valueType := f.field.GetDereferencedType().Elem()
+	_, structValues := getNestedStructType(valueType)
+	structValues = structValues && dive && valueType.Kind() != reflect.Slice && valueType.Kind() != reflect.Map
+	var (
+		stringFn func(c str.BaseConfigurator)
+		numberFn func(c num.BaseConfigurator)
+	)
+	if len(elemRules) > 0 {
+		if structValues {
+			return nil, f.errorf("rules after dive are not supported for values of type %s", valueType.String())
+		}
+		var err error
+		stringFn, numberFn, err = validateElementRules(f, valueType, elemRules)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(steps) == 0 && stringFn == nil && numberFn == nil && !structValues {
+		return nil, nil
+	}
+	return func(c tagConfigurator) {
+		mc := c.Map(f.ptr)
+		sc := mc.MapFieldConfigurator.Bail()
+		for _, step := range steps {
+			sc = step(sc)
+		}
+		switch {
+		case stringFn != nil:
+			stringFn(mc.StringValues())
+		case numberFn != nil:
+			numberFn(mc.NumberValues())
+		case structValues:
+			mc.StructValues()
+		}
+	}, nil
+}
+
+// ConfigureFromValidateTags configures a Validator instance for a specific type T, i.e.: var t *T,
+// from the go-playground/validator compatible struct tags, i.e. `validate:"required,gte=0,lte=130"`.
+// It is intended for the incremental migration from the go-playground/validator,
+// the types of the nested struct fields are configured from their tags too.
+// It returns the error wrapping ErrInvalidTags for unsupported rules,
+// the rules of the type are registered only if all its tags are valid.
+//
+// Supported rules:
+//   - required: not empty string, not zero number, not nil pointer, slice or map, not nil uuid.UUID;
+//   - omitempty: the following rules are skipped for the zero value;
+//   - min, max, len, gte, lte, gt, lt: the value of numbers, the characters (runes) count of strings,
+//     the length of slices, the entries count of maps;
+//   - oneof: space separated allowed values of strings and numbers;
+//   - email, uuid: strings;
+//   - eqfield, nefield for strings and numbers, gtfield, gtefield, ltfield, ltefield for numbers;
+//   - dive: the following rules are applied to each string or number element of slices and map values,
+//     required after dive checks the pointers to struct elements of slices are not nil.
+//
+// The nested structs, slices of structs and map values of structs after dive are validated
+// with the rules of their types.
+func ConfigureFromValidateTags[T any](v *Validator) error {
+	return v.configureFromTags(validateDialect, reflect.TypeOf(new(T)))
+}
@@ -0,0 +1,161 @@
+package valigo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type validateTagsItem struct {
+	SKU string `validate:"required,len=4"`
+}
+
+type validateTagsOrder struct {
+	ID        uuid.UUID                   `validate:"required,uuid"`
+	RequestID string                      `validate:"omitempty,uuid"`
+	Customer  string                      `validate:"required,min=3,max=8"`
+	Email     *string                     `validate:"omitempty,email"`
+	Age       int                         `validate:"required,gte=18,lte=130"`
+	Discount  float64                     `validate:"gt=0,lt=1"`
+	Currency  string                      `validate:"oneof=EUR USD"`
+	Quantity  *uint8                      `validate:"required,oneof=1 2 3"`
+	Password  string                      `validate:"required"`
+	Confirm   string                      `validate:"eqfield=Password"`
+	Tags      []string                    `validate:"required,max=2,dive,required,max=5"`
+	Scores    []int                       `validate:"omitempty,min=1,dive,gt=0"`
+	Notes     []*string                   `validate:"dive,omitempty,min=2"`
+	Limits    map[string]int              `validate:"max=2,dive,lte=10"`
+	Items     []validateTagsItem          `validate:"required,dive"`
+	Children  map[string]validateTagsItem `validate:"dive"`
+	Parent    *validateTagsItem           `validate:"omitempty"`
+	Ignored   string                      `validate:"-"`
+}
+
+func TestConfigureFromValidateTags(t *testing.T) {
+	v := New()
+	assert.NoError(t, ConfigureFromValidateTags[validateTagsOrder](v))
+
+	quantity := uint8(2)
+	valid := &validateTagsOrder{
+		ID:       uuid.New(),
+		Customer: "alex",
+		Age:      20,
+		Discount: 0.5,
+		Currency: "EUR",
+		Quantity: &quantity,
+		Password: "secret",
+		Confirm:  "secret",
+		Tags:     []string{"a"},
+		Notes:    []*string{nil},
+		Limits:   map[string]int{"a": 1},
+		Items:    []validateTagsItem{{SKU: "ABCD"}},
+	}
+	assert.Empty(t, v.ValidateTyped(context.Background(), valid))
+
+	email, note, invalidQuantity := "alex", "a", uint8(5)
+	errs := v.ValidateTyped(context.Background(), &validateTagsOrder{
+		RequestID: "123",
+		Customer:  "al",
+		Email:     &email,
+		Age:       0,
+		Discount:  1,
+		Currency:  "GBP",
+		Quantity:  &invalidQuantity,
+		Confirm:   "other",
+		Tags:      []string{"", "a", "long tag"},
+		Scores:    []int{0},
+		Notes:     []*string{&note},
+		Limits:    map[string]int{"a": 11},
+		Items:     []validateTagsItem{{SKU: "ABC"}},
+		Children:  map[string]validateTagsItem{"c": {}},
+		Parent:    &validateTagsItem{},
+	})
+	var locations []string
+	for _, err := range errs {
+		locations = append(locations, err.Location)
+	}
+	assert.Equal(t, []string{"ID", "RequestID", "Customer", "Email", "Age", "Discount", "Currency",
+		"Quantity", "Password", "Confirm", "Tags", "Tags[0]", "Tags[2]", "Scores[0]", "Notes[0]", "Limits[a]",
+		"Items[0].SKU", "Children[c].SKU", "Parent.SKU"}, locations)
+}
+
+func TestConfigureFromValidateTagsErrors(t *testing.T) {
+	type unsupportedRule struct {
+		Name string `validate:"required,alphanum"`
+	}
+	type alternative struct {
+		Name string `validate:"email|uuid"`
+	}
+	type nestedDive struct {
+		Matrix [][]int `validate:"dive,dive,min=1"`
+	}
+	type diveOnString struct {
+		Name string `validate:"dive,required"`
+	}
+	type diveOnStructs struct {
+		Items []*validateTagsItem `validate:"dive,min=1"`
+	}
+	type mapKeys struct {
+		Values map[string]string `validate:"dive,keys,min=1,endkeys"`
+	}
+	type elementCrossField struct {
+		Min    int
+		Values []int `validate:"dive,gtfield=Min"`
+	}
+	v := New()
+	for _, err := range []error{
+		ConfigureFromValidateTags[unsupportedRule](v),
+		ConfigureFromValidateTags[alternative](v),
+		ConfigureFromValidateTags[nestedDive](v),
+		ConfigureFromValidateTags[diveOnString](v),
+		ConfigureFromValidateTags[diveOnStructs](v),
+		ConfigureFromValidateTags[mapKeys](v),
+		ConfigureFromValidateTags[elementCrossField](v),
+	} {
+		assert.ErrorIs(t, err, ErrInvalidTags)
+	}
+	assert.ErrorContains(t, ConfigureFromValidateTags[unsupportedRule](v),
+		`field Name: unsupported rule "alphanum" for type string`)
+}
+
+func TestConfigureFromValidateTagsStructDive(t *testing.T) {
+	type order struct {
+		Items    []*validateTagsItem `validate:"required,dive,required"`
+		Variants []validateTagsItem  `validate:"omitempty,max=1,dive,required"`
+	}
+	v := New()
+	assert.NoError(t, ConfigureFromValidateTags[order](v))
+	assert.Empty(t, v.ValidateTyped(context.Background(), &order{Items: []*validateTagsItem{{SKU: "ABCD"}}}))
+
+	errs := v.ValidateTyped(context.Background(), &order{
+		Items:    []*validateTagsItem{nil, {SKU: "ABC"}},
+		Variants: []validateTagsItem{{SKU: "ABCD"}, {SKU: "ABCD"}},
+	})
+	var locations []string
+	for _, err := range errs {
+		locations = append(locations, err.Location)
+	}
+	assert.Equal(t, []string{"Items[1].SKU", "Items[0]", "Variants"}, locations)
+	assert.ErrorIs(t, errs[1], ErrStructRequired)
+}
+
+func TestConfigureFromValidateTagsRunes(t *testing.T) {
+	type user struct {
+		Name string `validate:"min=2,max=3"`
+		Code string `validate:"len=2"`
+	}
+	v := New()
+	assert.NoError(t, ConfigureFromValidateTags[user](v))
+	assert.Empty(t, v.ValidateTyped(context.Background(), &user{Name: "абв", Code: "яя"}))
+	assert.Len(t, v.ValidateTyped(context.Background(), &user{Name: "абвг", Code: "я"}), 2)
+}
+
+func TestWithValidateTagsConfiguration(t *testing.T) {
+	v := New(WithValidateTagsConfiguration())
+	errs := v.ValidateTyped(context.Background(), &validateTagsItem{SKU: "ABC"})
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "SKU", errs[0].Location)
+	assert.Equal(t, "Cannot be shorter than 4 characters", errs[0].Message)
+}
@@ -2,29 +2,154 @@ package valigo
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/insei/valigo/shared"
+	"github.com/insei/valigo/translator"
+)
+
+const (
+	interruptedLocaleKey = "validation:context:Validation was interrupted"
 )
 
 // Validator is a struct that holds a storage and a helper object.
+//
+// Validator is safe for concurrent use: types can be configured with Configure at any time,
+// including while other goroutines are validating. Registered validators are stored
+// in copy-on-write snapshots, validation always uses the latest published snapshot.
 type Validator struct {
 	storage        *storage
 	helper         *helper
 	transformError func(errs []shared.Error) []error
+	autoNested     bool
+	maxErrors      int
+	asyncLimit     int
+	// tagDialect is the dialect of the tags used to configure the types lazily on the first validation,
+	// nil means lazy tags configuration is disabled.
+	tagDialect *tagDialect
+	// tagsConfigured is a cache of the types configured from tags.
+	tagsConfigured sync.Map
+	// configErrs collects the configuration errors of Configure,
+	// nil means the configuration errors panic.
+	configErrs *configErrors
+}
+
+// configErrors is a list of the configuration errors safe for concurrent use.
+type configErrors struct {
+	mu   sync.Mutex
+	errs []error
 }
 
 // ValidateTyped validates an object of any type using validators from the storage.
 // It takes a context.Context and an object as input and returns a slice of shared.Error objects.
 // If no validators are found for the object's type, it returns nil.
+// If the ctx is done, the validation stops and the errors found so far are returned
+// along with the error wrapping shared.ErrValidationInterrupted and the context error.
 func (v *Validator) ValidateTyped(ctx context.Context, obj any) []shared.Error {
-	validators, ok := v.storage.validators[reflect.TypeOf(obj)]
-	if !ok {
-		return nil
+	return v.validateRoot(ctx, obj)
+}
+
+// validateRoot normalizes and validates the root object and appends the interruption error if the ctx is done,
+// the deep copy of the object is normalized and validated for the dry-run validation.
+// Async rules run concurrently if enabled, their results are placed in the rules declaration order.
+func (v *Validator) validateRoot(ctx context.Context, obj any) []shared.Error {
+	if isDryRun(ctx) {
+		obj = deepCopy(obj)
+	}
+	if v.tagDialect != nil && v.storage.getPlan(reflect.TypeOf(obj)) == nil {
+		// the configuration errors are returned by the validation
+		_ = v.configureLazyFromTags(obj)
+	}
+	v.normalize(ctx, obj)
+	var runner *asyncRunner
+	if v.asyncLimit > 0 {
+		runner = newAsyncRunner(v.asyncLimit)
+		ctx = shared.WithAsyncRunner(ctx, runner)
+	}
+	errs := v.validate(ctx, obj)
+	if runner != nil {
+		errs = runner.resolve(errs)
+		if v.maxErrors > 0 && len(errs) > v.maxErrors {
+			errs = errs[:v.maxErrors]
+		}
 	}
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, shared.Error{
+			Message: v.helper.t.T(ctx, interruptedLocaleKey),
+			Err:     fmt.Errorf("%w: %w", shared.ErrValidationInterrupted, err),
+		})
+	}
+	return errs
+}
+
+// validate walks the validation plan compiled for the object's type, calls the Validate method
+// of the self-validating object and, if enabled, validates nested struct fields automatically.
+// The validation stops when the errors limit is reached.
+// For the partial validation only the steps matching the fields selection from the ctx run.
+func (v *Validator) validate(ctx context.Context, obj any) []shared.Error {
 	var errs []shared.Error
-	for _, validator := range validators {
-		errs = append(errs, validator(ctx, v.helper, obj)...)
+	sel := selectionFromContext(ctx)
+	maxErrors := v.maxErrors
+	if v.asyncLimit > 0 && shared.AsyncRunnerFromContext(ctx) != nil {
+		// errors contain placeholders of the async rules, the limit is applied after they are resolved
+		maxErrors = 0
+	}
+	p := v.storage.getPlan(reflect.TypeOf(obj))
+	if p == nil && v.tagDialect != nil {
+		if err := v.configureLazyFromTags(obj); err != nil {
+			return []shared.Error{{Message: err.Error(), Err: err}}
+		}
+		p = v.storage.getPlan(reflect.TypeOf(obj))
+	}
+	if p != nil {
+		for _, b := range p.blocks {
+			if len(b.groups) > 0 && !groupsFromContext(ctx).containsAll(b.groups) {
+				continue
+			}
+			if b.enabler != nil && !b.enabler(ctx, obj) {
+				continue
+			}
+			for _, step := range b.steps {
+				stepCtx := ctx
+				if sel != nil {
+					var ok bool
+					if stepCtx, ok = step.matchSelection(ctx, sel); !ok {
+						continue
+					}
+				}
+				value := obj
+				if step.field != nil {
+					value = step.field.GetPtr(obj)
+				}
+				for _, fn := range step.fns {
+					if ctx.Err() != nil {
+						return errs
+					}
+					errs = append(errs, fn(stepCtx, v.helper, value)...)
+					if maxErrors > 0 && len(errs) >= maxErrors {
+						return errs[:maxErrors]
+					}
+				}
+			}
+		}
+	}
+	if sel == nil && ctx.Err() == nil {
+		errs = append(errs, v.validateSelf(ctx, obj)...)
+		if maxErrors > 0 && len(errs) >= maxErrors {
+			return errs[:maxErrors]
+		}
+	}
+	if v.autoNested {
+		errs = append(errs, v.validateAutoNested(ctx, obj)...)
+	} else {
+		// the self-validating nested values are validated without rules configured for their fields
+		errs = append(errs, v.validateValidatableNested(ctx, obj, true)...)
+	}
+	if maxErrors > 0 && len(errs) > maxErrors {
+		return errs[:maxErrors]
 	}
 	return errs
 }
@@ -41,6 +166,28 @@ func (v *Validator) GetHelper() shared.Helper {
 	return v.helper
 }
 
+// GetTranslator returns the translator of the Validator, see WithTranslator.
+func (v *Validator) GetTranslator() translator.Translator {
+	return v.helper.t
+}
+
+// FieldLocation returns the location of the field of the struct type t by the struct path (i.e. "Address.City")
+// used in errors, see WithFieldLocationNamingFn. It returns false if the field is not found.
+func (v *Validator) FieldLocation(t reflect.Type, structPath string) (string, bool) {
+	if t.Kind() != reflect.Struct {
+		return "", false
+	}
+	fields, err := getFields(reflect.New(t).Interface())
+	if err != nil {
+		return "", false
+	}
+	field, ok := fields.Find(structPath)
+	if !ok {
+		return "", false
+	}
+	return v.helper.getFieldLocation(field), true
+}
+
 // New creates a new Validator instance with default values for storage and helper.
 // It also applies any options passed to the function to the new instance.
 func New(opts ...Option) *Validator {
@@ -65,11 +212,67 @@ func New(opts ...Option) *Validator {
 // It creates a new instance of type T, allocates values for all fields recursively,
 // creates a Configurator instance for the type T, calls the provided function fn with the Configurator
 // instance and the instance of type T, and appends any user-defined validators to the Configurator instance.
+//
+// Configure panics on the configuration errors, i.e. rules of the unsupported field type,
+// unless the Validator is created with WithConfigurationErrors, then the errors are returned by Validator.Err.
 func Configure[T any](v *Validator, fn func(builder Configurator[T], m *T)) {
+	configureType(v, fn, v.reportConfigError)
+}
+
+// ConfigureE is similar to Configure, but it returns the configuration errors instead of panicking.
+// The returned error joins all the configuration errors of the type, each error wraps
+// shared.ErrInvalidConfiguration and contains the type and field names.
+// The rules of the invalid configuration are discarded, the valid rules are registered.
+func ConfigureE[T any](v *Validator, fn func(builder Configurator[T], m *T)) error {
+	var errs []error
+	configureType(v, fn, func(err error) {
+		errs = append(errs, err)
+	})
+	return errors.Join(errs...)
+}
+
+// configureType configures a Validator instance for a specific type T
+// and reports the configuration errors with the type name using the reportFn.
+func configureType[T any](v *Validator, fn func(builder Configurator[T], m *T), reportFn func(err error)) {
 	model := new(T)
+	errFn := shared.ConfigErrorFn(func(err error) {
+		reportFn(fmt.Errorf("%s: %w", reflect.TypeOf(model).Elem().String(), err))
+	})
 	// allocate all pointer fields values recursively
-	mustZero(model)
-	b := configure[T](v, model, nil)
+	if err := zero(model); err != nil {
+		errFn.Report(shared.NewConfigError(nil, "%v", err))
+		return
+	}
+	if _, err := getFields(model); err != nil {
+		errFn.Report(shared.NewConfigError(nil, "%v", err))
+		return
+	}
+	b := configure[T](v, model, nil, errFn)
 	// Append users validators
 	fn(b, model)
+	// publish all the type rules at once
+	b.batch.commit()
+}
+
+// reportConfigError collects the configuration error if enabled with WithConfigurationErrors,
+// otherwise it panics with the error.
+func (v *Validator) reportConfigError(err error) {
+	if v.configErrs == nil {
+		panic(err)
+	}
+	v.configErrs.mu.Lock()
+	defer v.configErrs.mu.Unlock()
+	v.configErrs.errs = append(v.configErrs.errs, err)
+}
+
+// Err returns the configuration errors collected by Configure if the Validator is created
+// with WithConfigurationErrors, i.e. to report all the invalid rules at the application startup.
+// The returned error joins all the errors, it is nil if there are no errors.
+func (v *Validator) Err() error {
+	if v.configErrs == nil {
+		return nil
+	}
+	v.configErrs.mu.Lock()
+	defer v.configErrs.mu.Unlock()
+	return errors.Join(v.configErrs.errs...)
 }
@@ -2,12 +2,16 @@ package valigo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/insei/fmap/v3"
 
 	"github.com/insei/valigo/shared"
 )
@@ -140,7 +144,7 @@ func TestValidatorValidateTyped(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			v := New()
-			v.storage.validators[reflect.TypeOf(test.obj)] = []structValidationFn{test.validator}
+			v.storage.appendRule(reflect.TypeOf(test.obj), rule{fn: test.validator})
 			errs := v.ValidateTyped(context.Background(), test.obj)
 			if len(errs) != test.expectedErrs {
 				t.Errorf("expected %d errors, got %d", test.expectedErrs, len(errs))
@@ -213,7 +217,7 @@ func TestValidatorValidate(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			v := New()
-			v.storage.validators[reflect.TypeOf(test.obj)] = []structValidationFn{test.validator}
+			v.storage.appendRule(reflect.TypeOf(test.obj), rule{fn: test.validator})
 			if test.transformError != nil {
 				v.transformError = test.transformError
 			}
@@ -311,3 +315,348 @@ func TestConfigure(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorBail(t *testing.T) {
+	type TestStruct struct {
+		Name string
+		Age  int
+	}
+	v := New()
+	Configure[TestStruct](v, func(c Configurator[TestStruct], obj *TestStruct) {
+		c.String(&obj.Name).Bail().Required().MinLen(3).Regexp(regexp.MustCompile(`^[a-z]+$`))
+		c.Number(&obj.Age).Required().Bail().Min(18).Max(10)
+	})
+	errs := v.ValidateTyped(context.Background(), &TestStruct{Age: 5})
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Location != "Name" || errs[1].Location != "Age" {
+		t.Errorf("unexpected errors locations: %v", errs)
+	}
+}
+
+func TestValidatorMaxErrors(t *testing.T) {
+	type TestStruct struct {
+		Name     string
+		LastName string
+		Email    string
+	}
+	tests := []struct {
+		name         string
+		opts         []Option
+		expectedErrs int
+	}{
+		{
+			name:         "no limit",
+			expectedErrs: 3,
+		},
+		{
+			name:         "max errors",
+			opts:         []Option{WithMaxErrors(2)},
+			expectedErrs: 2,
+		},
+		{
+			name:         "fail fast",
+			opts:         []Option{WithFailFast()},
+			expectedErrs: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := New(test.opts...)
+			Configure[TestStruct](v, func(c Configurator[TestStruct], obj *TestStruct) {
+				c.String(&obj.Name).Required()
+				c.String(&obj.LastName).Required()
+				c.String(&obj.Email).Required()
+			})
+			errs := v.ValidateTyped(context.Background(), &TestStruct{})
+			if len(errs) != test.expectedErrs {
+				t.Errorf("expected %d errors, got %d", test.expectedErrs, len(errs))
+			}
+		})
+	}
+}
+
+func TestValidatorContextCancellation(t *testing.T) {
+	type TestStruct struct {
+		Name     string
+		LastName string
+		Email    string
+	}
+	v := New()
+	Configure[TestStruct](v, func(c Configurator[TestStruct], obj *TestStruct) {
+		c.String(&obj.Name).Required()
+		c.String(&obj.LastName).Custom(func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error {
+			cancel := ctx.Value(cancelCtxKey{}).(context.CancelFunc)
+			cancel()
+			return []shared.Error{h.ErrorT(ctx, value, "cancelled")}
+		})
+		c.String(&obj.Email).Required()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = context.WithValue(ctx, cancelCtxKey{}, cancel)
+	errs := v.ValidateTyped(ctx, &TestStruct{})
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Location != "Name" || errs[1].Location != "LastName" {
+		t.Errorf("unexpected errors locations: %v", errs)
+	}
+	if !errors.Is(errs[2], shared.ErrValidationInterrupted) || !errors.Is(errs[2], context.Canceled) {
+		t.Errorf("expected interruption error, got %v", errs[2])
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+	errs = v.ValidateTyped(ctx, &TestStruct{})
+	if len(errs) != 1 || !errors.Is(errs[0], context.DeadlineExceeded) {
+		t.Errorf("expected deadline error only, got %v", errs)
+	}
+}
+
+type cancelCtxKey struct{}
+
+func TestValidatorCustomTimeout(t *testing.T) {
+	type TestStruct struct {
+		Name string
+	}
+	v := New()
+	Configure[TestStruct](v, func(c Configurator[TestStruct], obj *TestStruct) {
+		c.String(&obj.Name).Custom(func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error {
+			<-ctx.Done()
+			return []shared.Error{h.ErrorT(ctx, value, "lookup timeout")}
+		}, shared.WithTimeout(time.Millisecond))
+		c.Custom(func(ctx context.Context, h shared.StructCustomHelper, obj *TestStruct) []shared.Error {
+			if _, ok := ctx.Deadline(); !ok {
+				return []shared.Error{h.ErrorT(ctx, &obj.Name, obj.Name, "no deadline")}
+			}
+			return nil
+		}, shared.WithTimeout(time.Second))
+	})
+	errs := v.ValidateTyped(context.Background(), &TestStruct{})
+	if len(errs) != 1 || errs[0].Message != "lookup timeout" {
+		t.Errorf("expected lookup timeout error, got %v", errs)
+	}
+}
+
+func TestValidatorCrossField(t *testing.T) {
+	type TestStruct struct {
+		Password        string
+		PasswordConfirm *string
+		Login           string
+		MinPrice        int
+		MaxPrice        *int
+		Discount        int
+	}
+	v := New(WithFieldLocationNamingFn(func(field fmap.Field) string {
+		return strings.ToLower(field.GetStructPath())
+	}))
+	Configure[TestStruct](v, func(c Configurator[TestStruct], obj *TestStruct) {
+		c.String(&obj.PasswordConfirm).EqField(&obj.Password)
+		c.String(&obj.Login).NeField(&obj.Password)
+		c.Number(&obj.MaxPrice).GteField(&obj.MinPrice).GtField(&obj.Discount)
+		c.Number(&obj.MinPrice).LtField(&obj.MaxPrice).LteField(&obj.MaxPrice).NeField(&obj.Discount)
+		c.Number(&obj.Discount).EqField(&obj.Discount)
+	})
+	confirm, maxPrice := "secret", 20
+	errs := v.ValidateTyped(context.Background(), &TestStruct{
+		Password:        "secret",
+		PasswordConfirm: &confirm,
+		Login:           "admin",
+		MinPrice:        10,
+		MaxPrice:        &maxPrice,
+	})
+	if len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	confirm, maxPrice = "other", 5
+	errs = v.ValidateTyped(context.Background(), &TestStruct{
+		Password:        "secret",
+		PasswordConfirm: &confirm,
+		Login:           "secret",
+		MinPrice:        10,
+		MaxPrice:        &maxPrice,
+		Discount:        10,
+	})
+	expected := []shared.Error{
+		{Location: "passwordconfirm", Message: "Should be equal to password"},
+		{Location: "login", Message: "Should not be equal to password"},
+		{Location: "maxprice", Message: "Should be greater than or equal to minprice"},
+		{Location: "maxprice", Message: "Should be greater than discount"},
+		{Location: "minprice", Message: "Should be less than maxprice"},
+		{Location: "minprice", Message: "Should be less than or equal to maxprice"},
+		{Location: "minprice", Message: "Should not be equal to discount"},
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(errs), errs)
+	}
+	for i, err := range errs {
+		if err.Location != expected[i].Location || err.Message != expected[i].Message {
+			t.Errorf("expected %v, got %v", expected[i], err)
+		}
+	}
+
+	// nil other field value skips the rule, nil field value fails it
+	errs = v.ValidateTyped(context.Background(), &TestStruct{Password: "secret", PasswordConfirm: &confirm})
+	if len(errs) != 4 || errs[3].Location != "minprice" || errs[3].Message != "Should not be equal to discount" {
+		t.Errorf("expected 4 errors, got %v", errs)
+	}
+}
+
+func TestValidatorErrorCodes(t *testing.T) {
+	type Line struct {
+		Name string
+	}
+	type TestStruct struct {
+		Name     string
+		Confirm  string
+		Age      int
+		ID       uuid.UUID
+		Tags     []string
+		Labels   map[string]string
+		Line     *Line
+		Nickname string
+	}
+	v := New()
+	Configure[TestStruct](v, func(c Configurator[TestStruct], obj *TestStruct) {
+		c.String(&obj.Name).MaxLen(3).AnyOf("a", "b")
+		c.String(&obj.Confirm).EqField(&obj.Name)
+		c.Number(&obj.Age).AnyOfInterval(18, 120)
+		c.UUID(&obj.ID).Required()
+		c.StringSlice(&obj.Tags).Email()
+		c.Map(&obj.Labels).MinEntries(2)
+		c.Struct(&obj.Line).Required()
+		c.String(&obj.Nickname).Custom(func(ctx context.Context, h *shared.FieldCustomHelper, value any) []shared.Error {
+			return []shared.Error{h.ErrorT(ctx, value, "taken").WithCode("user.nickname_taken", shared.Param{Name: "nickname", Value: "a"})}
+		})
+	})
+	errs := v.ValidateTyped(context.Background(), &TestStruct{
+		Name:     "abcd",
+		Tags:     []string{"tag"},
+		Labels:   map[string]string{"a": "b"},
+		Nickname: "a",
+	})
+	expected := []shared.Error{
+		{Location: "Name", Code: "string.max_len", Params: shared.NewParams(shared.Param{Name: "max", Value: 3})},
+		{Location: "Name", Code: "string.any_of", Params: shared.NewParams(shared.Param{Name: "allowed", Value: []any{"a", "b"}})},
+		{Location: "Confirm", Code: "string.eq_field", Params: shared.NewParams(shared.Param{Name: "field", Value: "Name"})},
+		{Location: "Age", Code: "number.any_of_interval", Params: shared.NewParams(shared.Param{Name: "begin", Value: 18}, shared.Param{Name: "end", Value: 120})},
+		{Location: "ID", Code: "uuid.required"},
+		{Location: "Tags", Code: "string.email"},
+		{Location: "Labels", Code: "map.min_entries", Params: shared.NewParams(shared.Param{Name: "min", Value: 2})},
+		{Location: "Line", Code: "struct.required"},
+		{Location: "Nickname", Code: "user.nickname_taken", Params: shared.NewParams(shared.Param{Name: "nickname", Value: "a"})},
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(errs), errs)
+	}
+	for i, err := range errs {
+		if err.Location != expected[i].Location || err.Code != expected[i].Code || !reflect.DeepEqual(err.Params, expected[i].Params) {
+			t.Errorf("expected %s %s %v, got %s %s %v", expected[i].Location, expected[i].Code, expected[i].Params,
+				err.Location, err.Code, err.Params)
+		}
+		if err.LocaleKey == "" {
+			t.Errorf("expected locale key of %s error", err.Code)
+		}
+	}
+}
+
+func TestConfigureE(t *testing.T) {
+	type TestStruct struct {
+		Name    string
+		Age     int
+		Score   float64
+		Flag    bool
+		Tags    []string
+		Nested  struct{ Name string }
+		Other   string
+		Aliases []int
+	}
+	v := New()
+	err := ConfigureE[TestStruct](v, func(c Configurator[TestStruct], obj *TestStruct) {
+		c.String(&obj.Name).Required().MaxLen(3)
+		c.String(&obj.Flag).Required()
+		c.Number(&obj.Name).Min(1)
+		c.Number(&obj.Age).Max(int64(1)).Min(18)
+		c.Number(&obj.Score).AnyOf(1, 2)
+		c.Slice(&obj.Name).MaxLen(1)
+		c.Map(&obj.Age).Required()
+		c.Struct(&obj.Tags).Required()
+		c.StructSlice(&obj.Aliases)
+		c.String(&obj.Other).EqField(&obj.Age)
+		c.String(new(string)).Required()
+	})
+	if !errors.Is(err, shared.ErrInvalidConfiguration) {
+		t.Fatalf("expected error wrapping shared.ErrInvalidConfiguration, got %v", err)
+	}
+	expected := []string{
+		"valigo.TestStruct: invalid validation configuration: field Flag: type bool is not a string or pointer to string",
+		"valigo.TestStruct: invalid validation configuration: field Name: type string is not a number or pointer to number",
+		"valigo.TestStruct: invalid validation configuration: field Age: field dereferenced type is int, but maxNum type is int64",
+		"valigo.TestStruct: invalid validation configuration: field Score: can't cast value 1 to number type float64",
+		"valigo.TestStruct: invalid validation configuration: field Name: type string is not a slice",
+		"valigo.TestStruct: invalid validation configuration: field Age: type int is not a map",
+		"valigo.TestStruct: invalid validation configuration: field Tags: type []string is not a struct or pointer to struct",
+		"valigo.TestStruct: invalid validation configuration: field Aliases: type []int is not a slice of structs",
+		"valigo.TestStruct: invalid validation configuration: field Other: dereferenced type is string, but field Age dereferenced type is int",
+	}
+	errs := strings.Split(err.Error(), "\n")
+	if len(errs) != len(expected)+1 {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected)+1, len(errs), err)
+	}
+	for i, e := range expected {
+		if errs[i] != e {
+			t.Errorf("expected %q, got %q", e, errs[i])
+		}
+	}
+	if !strings.HasPrefix(errs[len(expected)], "valigo.TestStruct: invalid validation configuration: string field:") {
+		t.Errorf("expected unknown field error, got %q", errs[len(expected)])
+	}
+
+	// the valid rules are registered
+	errsV := v.ValidateTyped(context.Background(), &TestStruct{Name: "long", Age: 10})
+	if len(errsV) != 2 || errsV[0].Location != "Name" || errsV[1].Location != "Age" {
+		t.Errorf("expected Name and Age errors, got %v", errsV)
+	}
+
+	err = ConfigureE[TestStruct](v, func(c Configurator[TestStruct], obj *TestStruct) {
+		c.String(&obj.Name).Required()
+	})
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestValidatorErr(t *testing.T) {
+	type TestStruct struct {
+		Name string
+		Age  int
+	}
+	v := New(WithConfigurationErrors())
+	Configure[TestStruct](v, func(c Configurator[TestStruct], obj *TestStruct) {
+		c.Number(&obj.Name).Required()
+	})
+	Configure[TestStruct](v, func(c Configurator[TestStruct], obj *TestStruct) {
+		c.String(&obj.Age).Required()
+	})
+	err := v.Err()
+	if !errors.Is(err, shared.ErrInvalidConfiguration) {
+		t.Fatalf("expected error wrapping shared.ErrInvalidConfiguration, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "field Name") || !strings.Contains(err.Error(), "field Age") {
+		t.Errorf("expected errors of Name and Age fields, got %v", err)
+	}
+
+	if err = New().Err(); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Configure did not panic on the configuration error")
+		}
+	}()
+	Configure[TestStruct](New(), func(c Configurator[TestStruct], obj *TestStruct) {
+		c.Number(&obj.Name).Required()
+	})
+}
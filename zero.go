@@ -59,11 +59,3 @@ func zero(obj interface{}) error {
 	}
 	return err
 }
-
-// MustZero will panic instead of return error
-func mustZero(obj interface{}) {
-	err := zero(obj)
-	if err != nil {
-		panic(err)
-	}
-}
@@ -224,14 +224,3 @@ func TestZero(t *testing.T) {
 		})
 	}
 }
-
-func TestMustZero(t *testing.T) {
-	defer func() {
-		if r := recover(); r != nil {
-		} else {
-			t.Errorf("mustZero() did not panic")
-		}
-	}()
-
-	mustZero("hello")
-}